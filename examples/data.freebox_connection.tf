@@ -0,0 +1,9 @@
+data "freebox_connection" "example" {}
+
+# Publish the public IPv4 address of the Freebox through another provider
+resource "cloudflare_record" "home" {
+  zone_id = "023e105f4ecef8ad9ca31a8372d0c353"
+  name    = "home"
+  type    = "A"
+  content = data.freebox_connection.example.ipv4
+}
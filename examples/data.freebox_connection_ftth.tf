@@ -0,0 +1,8 @@
+data "freebox_connection_ftth" "example" {}
+
+output "sfp_power_dbm" {
+  value = {
+    rx = data.freebox_connection_ftth.example.sfp_pwr_rx / 100
+    tx = data.freebox_connection_ftth.example.sfp_pwr_tx / 100
+  }
+}
@@ -0,0 +1,8 @@
+data "freebox_connection_xdsl" "example" {}
+
+output "line_rates" {
+  value = {
+    up   = data.freebox_connection_xdsl.example.up.rate
+    down = data.freebox_connection_xdsl.example.down.rate
+  }
+}
@@ -0,0 +1,20 @@
+data "freebox_files" "images" {
+  path      = "/Freebox/VMs"
+  recursive = true
+  max_depth = 2
+  glob      = "*.qcow2"
+}
+
+# Adopt the existing disk images with the import support of freebox_remote_file
+import {
+  for_each = { for file in data.freebox_files.images.files : file.path => file if file.type == "file" }
+  to       = freebox_remote_file.images[each.key]
+  id       = each.value.path
+}
+
+resource "freebox_remote_file" "images" {
+  for_each = { for file in data.freebox_files.images.files : file.path => file if file.type == "file" }
+
+  destination_path = each.value.path
+  source_url       = "https://example.com/images/${each.value.name}"
+}
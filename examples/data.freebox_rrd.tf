@@ -0,0 +1,17 @@
+# Bandwidth usage of the last 24 hours, one point every 10 minutes
+data "freebox_rrd" "bandwidth" {
+  db         = "net"
+  fields     = ["rate_up", "rate_down"]
+  start      = timeadd(plantimestamp(), "-24h")
+  resolution = "10m"
+}
+
+# Temperatures with one decimal, in tenths of degree
+data "freebox_rrd" "temperatures" {
+  db        = "temp"
+  precision = 10
+}
+
+output "peak_download_rate" {
+  value = max([for point in data.freebox_rrd.bandwidth.series["rate_down"] : point.value]...)
+}
@@ -0,0 +1,7 @@
+data "freebox_storage_disks" "example" {}
+
+output "disk_temperatures" {
+  value = {
+    for disk in data.freebox_storage_disks.example.disks : disk.serial => disk.temperature
+  }
+}
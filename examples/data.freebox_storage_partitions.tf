@@ -0,0 +1,22 @@
+data "freebox_storage_partitions" "example" {}
+
+locals {
+  main_partition = one([
+    for partition in data.freebox_storage_partitions.example.partitions : partition
+    if partition.path == "/Freebox"
+  ])
+  virtual_disk_size = 10 * 1024 * 1024 * 1024 # 10 GB
+}
+
+# Make sure the virtual disk fits on the storage before creating it
+resource "freebox_virtual_disk" "example" {
+  path         = "/Freebox/VMs/disk.qcow2"
+  virtual_size = local.virtual_disk_size
+
+  lifecycle {
+    precondition {
+      condition     = local.main_partition.free_bytes > local.virtual_disk_size
+      error_message = "Not enough free space on the Freebox storage for the virtual disk."
+    }
+  }
+}
@@ -0,0 +1,12 @@
+data "freebox_switch_ports" "example" {}
+
+output "switch_ports" {
+  value = {
+    for port in data.freebox_switch_ports.example.ports : port.name => {
+      link   = port.link
+      mode   = port.mode
+      hosts  = [for host in port.hosts : host.mac]
+      errors = port.rx_errors + port.tx_errors
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "freebox_upnp_redirections" "all" {}
+
+output "opened_ports" {
+  value = [
+    for redirection in data.freebox_upnp_redirections.all.redirections : {
+      port = "${redirection.ext_port}/${redirection.ip_protocol}"
+      host = redirection.host == null ? redirection.int_ip : redirection.host.primary_name
+    }
+  ]
+}
@@ -0,0 +1,10 @@
+data "freebox_vpn_connections" "example" {
+  server = "openvpn_routed"
+}
+
+output "vpn_traffic" {
+  value = {
+    for connection in data.freebox_vpn_connections.example.connections :
+    connection.user => connection.rx_bytes + connection.tx_bytes
+  }
+}
@@ -0,0 +1,10 @@
+data "freebox_wifi_allowed_channels" "five_ghz" {
+  ap_id = 1
+}
+
+output "five_ghz_80mhz_channels" {
+  value = [
+    for combination in data.freebox_wifi_allowed_channels.five_ghz.combinations :
+    combination.primary_channel if combination.channel_width == "80"
+  ]
+}
@@ -0,0 +1,2 @@
+# The AFP configuration is a singleton resource; use "afp" as the import ID
+terraform import "freebox_afp_config.example" afp
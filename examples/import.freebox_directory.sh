@@ -0,0 +1,2 @@
+# ------------------------------------------ 👇 is the path of the directory on the freebox disk
+terraform import "freebox_directory.example" /Freebox/VMs/example
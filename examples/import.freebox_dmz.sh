@@ -0,0 +1,2 @@
+# The DMZ is a singleton resource; use "dmz" as the import ID
+terraform import "freebox_dmz.example" dmz
@@ -0,0 +1,2 @@
+# The DNS configuration is a singleton resource; use "dns" as the import ID
+terraform import "freebox_dns_config.example" dns
@@ -0,0 +1,2 @@
+# ------------------------------- 👇 is the dynamic DNS service (dyndns, noip or ovh)
+terraform import "freebox_dyndns.example" noip
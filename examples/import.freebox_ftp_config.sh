@@ -0,0 +1,2 @@
+# The FTP configuration is a singleton resource; use "ftp" as the import ID
+terraform import "freebox_ftp_config.example" ftp
@@ -0,0 +1,2 @@
+# Incoming ports can be imported using the identifier of their service
+terraform import "freebox_incoming_port.remote_access" https_remote_access
@@ -0,0 +1,2 @@
+# ------------------------------------------- 👇 is the interface and the ID of the host separated by a slash
+terraform import "freebox_lan_host.build_server" pub/ether-00:11:22:33:44:55
@@ -0,0 +1,2 @@
+# --------------------------------------------------- 👇 is the ID of the profile
+terraform import "freebox_network_control_profile.kids" 3
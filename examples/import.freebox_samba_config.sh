@@ -0,0 +1,2 @@
+# The Samba configuration is a singleton resource; use "samba" as the import ID
+terraform import "freebox_samba_config.example" samba
@@ -0,0 +1,2 @@
+# ----------------------------------- 👇 is the token of the share link
+terraform import "freebox_share_link.example" Abcd1234EfGh5678
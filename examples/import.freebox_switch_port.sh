@@ -0,0 +1,2 @@
+# ----------------------------------- 👇 is the identifier of the port
+terraform import "freebox_switch_port.example" 2
@@ -0,0 +1,2 @@
+# The UPnP IGD configuration is a singleton resource; use "upnpigd" as the import ID
+terraform import "freebox_upnp_igd_config.example" upnpigd
@@ -0,0 +1,2 @@
+# ----------------------------------------- 👇 is the ID of the access point
+terraform import "freebox_wifi_ap.five_ghz" 1
@@ -0,0 +1,2 @@
+# ------------------------------------ 👇 is the BSSID of the BSS
+terraform import "freebox_wifi_bss.office" 00:11:22:33:44:55
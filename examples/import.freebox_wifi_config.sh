@@ -0,0 +1,2 @@
+# The Wi-Fi configuration is a singleton resource; use "wifi" as the import ID
+terraform import "freebox_wifi_config.example" wifi
@@ -0,0 +1,2 @@
+# ------------------------------------------------- 👇 is the ID of the guest key
+terraform import "freebox_wifi_guest_key.front_desk" 2
@@ -0,0 +1,2 @@
+# The MAC filter is a singleton resource; use "mac_filter" as the import ID
+terraform import "freebox_wifi_mac_filter.main" mac_filter
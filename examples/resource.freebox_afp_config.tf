@@ -0,0 +1,10 @@
+variable "afp_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "freebox_afp_config" "example" {
+  server_type    = "macmini"
+  login_name     = "freebox"
+  login_password = var.afp_password
+}
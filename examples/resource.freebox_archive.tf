@@ -0,0 +1,18 @@
+# Package the configuration of the virtual machines for an off-site backup
+resource "freebox_archive" "vm_configs" {
+  destination_path = "/Freebox/Backups/vm-configs.tar.gz"
+  source_paths = [
+    "/Freebox/VMs/cloud-init",
+    "/Freebox/VMs/router.yaml",
+  ]
+}
+
+resource "freebox_share_link" "vm_configs" {
+  path       = freebox_archive.vm_configs.destination_path
+  expires_at = timeadd(plantimestamp(), "24h")
+
+  lifecycle {
+    replace_triggered_by = [freebox_archive.vm_configs]
+    ignore_changes       = [expires_at]
+  }
+}
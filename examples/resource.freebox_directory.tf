@@ -0,0 +1,10 @@
+# The module owns its folder and everything it puts in it
+resource "freebox_directory" "vms" {
+  path          = "/Freebox/VMs/example"
+  force_destroy = true
+}
+
+resource "freebox_remote_file" "image" {
+  destination_path = "${freebox_directory.vms.path}/alpine.qcow2"
+  source_url       = "https://example.com/images/alpine.qcow2"
+}
@@ -0,0 +1,4 @@
+resource "freebox_dmz" "example" {
+  enabled   = true
+  target_ip = "192.168.1.42"
+}
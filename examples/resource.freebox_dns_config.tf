@@ -0,0 +1,22 @@
+# Pin the address of a Pi-hole virtual machine and hand it out as the DNS server of the local network
+resource "freebox_virtual_machine" "pihole" {
+  name      = "pihole"
+  vcpus     = 1
+  memory    = 1024
+  disk_path = "Freebox/VMs/pihole.qcow2"
+  disk_type = "qcow2"
+}
+
+resource "freebox_dhcp_lease" "pihole" {
+  mac      = freebox_virtual_machine.pihole.mac
+  ip       = "192.168.1.53"
+  hostname = "pihole"
+  comment  = "Pi-hole DNS server"
+}
+
+resource "freebox_dns_config" "example" {
+  servers = [
+    freebox_dhcp_lease.pihole.ip,
+    "1.1.1.1", # Fallback while the virtual machine is down
+  ]
+}
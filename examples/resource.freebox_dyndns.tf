@@ -0,0 +1,15 @@
+variable "noip_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "freebox_dyndns" "example" {
+  service  = "noip"
+  hostname = "home.example.ddns.net"
+  user     = "someone@example.org"
+  password = var.noip_password
+}
+
+output "dyndns_status" {
+  value = freebox_dyndns.example.status
+}
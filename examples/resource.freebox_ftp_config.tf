@@ -0,0 +1,16 @@
+variable "ftp_password" {
+  type      = string
+  sensitive = true
+}
+
+# Let partners push files over FTP from the Internet
+resource "freebox_ftp_config" "example" {
+  password            = var.ftp_password
+  password_version    = 1
+  allow_remote_access = true
+  port_ctrl           = 2121
+}
+
+output "ftp_url" {
+  value = "ftp://${freebox_ftp_config.example.remote_domain}:${freebox_ftp_config.example.port_ctrl}"
+}
@@ -0,0 +1,10 @@
+resource "freebox_incoming_port" "remote_access" {
+  id      = "https_remote_access"
+  enabled = true
+  in_port = 40443
+}
+
+resource "freebox_incoming_port" "ftp" {
+  id      = "ftp"
+  enabled = false
+}
@@ -0,0 +1,7 @@
+resource "freebox_lan_host" "build_server" {
+  interface    = "pub"
+  host_id      = "ether-00:11:22:33:44:55"
+  primary_name = "build-server"
+  host_type    = "workstation"
+  persistent   = true
+}
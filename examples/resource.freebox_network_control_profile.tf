@@ -0,0 +1,27 @@
+resource "freebox_network_control_profile" "kids" {
+  name      = "Kids"
+  rule_mode = "allowed"
+  macs      = ["00:11:22:33:44:55", "66:77:88:99:AA:BB"]
+
+  schedule = [
+    {
+      days  = ["monday", "tuesday", "wednesday", "thursday", "sunday"]
+      start = "21:30"
+      end   = "24:00"
+      mode  = "denied"
+    },
+    {
+      days  = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+      start = "00:00"
+      end   = "07:00"
+      mode  = "denied"
+    },
+  ]
+
+  host_overrides = {
+    "66:77:88:99:AA:BB" = {
+      mode  = "denied"
+      until = "2025-06-30T18:00:00Z"
+    }
+  }
+}
@@ -0,0 +1,12 @@
+variable "samba_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "freebox_samba_config" "example" {
+  workgroup      = "HOME"
+  logon_enabled  = true
+  logon_user     = "freebox"
+  logon_password = var.samba_password
+  smbv2_enabled  = true
+}
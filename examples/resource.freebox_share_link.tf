@@ -0,0 +1,19 @@
+resource "freebox_remote_file" "appliance" {
+  source_url       = "https://example.org/appliance.qcow2"
+  destination_path = "Freebox/Shares/appliance.qcow2"
+}
+
+# Share the downloaded appliance with a customer for a week
+resource "freebox_share_link" "example" {
+  path       = freebox_remote_file.appliance.destination_path
+  expires_at = timeadd(plantimestamp(), "168h")
+
+  lifecycle {
+    ignore_changes = [expires_at]
+  }
+}
+
+output "download_url" {
+  value     = freebox_share_link.example.url
+  sensitive = true
+}
@@ -0,0 +1,12 @@
+# Force a port to 100 Mbit/s full duplex for a device failing the auto-negotiation
+resource "freebox_switch_port" "example" {
+  id     = 2
+  speed  = "100"
+  duplex = "full"
+}
+
+# Disable an unused port
+resource "freebox_switch_port" "unused" {
+  id      = 4
+  enabled = false
+}
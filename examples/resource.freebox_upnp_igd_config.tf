@@ -0,0 +1,3 @@
+resource "freebox_upnp_igd_config" "example" {
+  enabled = false
+}
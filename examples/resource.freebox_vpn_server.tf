@@ -1,4 +1,9 @@
 resource "freebox_vpn_server" "example" {
   enabled     = true
-  server_port = 1194
+  server_port = 443
+  protocol    = "tcp" # Reachable from networks blocking UDP
+  cipher      = "aes256"
+  push_dns    = true
+  push_routes = ["192.168.1.0/24"]
+  max_clients = 10
 }
@@ -0,0 +1,10 @@
+resource "freebox_wake_on_lan" "build_server" {
+  interface          = "pub"
+  mac                = "00:11:22:33:44:55"
+  wait_for_reachable = true
+
+  triggers = {
+    # Wake the build server up again every time the virtual machine is replaced
+    virtual_machine = freebox_virtual_machine.example.id
+  }
+}
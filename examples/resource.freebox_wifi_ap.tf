@@ -0,0 +1,15 @@
+resource "freebox_wifi_ap" "five_ghz" {
+  id              = 1
+  band            = "5g"
+  channel_width   = "80"
+  primary_channel = 36
+  dfs_enabled     = false
+
+  vht = {
+    enabled = true
+  }
+
+  he = {
+    enabled = true
+  }
+}
@@ -0,0 +1,13 @@
+variable "office_wifi_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "freebox_wifi_bss" "office" {
+  id               = "00:11:22:33:44:55"
+  ssid             = "Office"
+  encryption       = "wpa23_psk_ccmp"
+  key              = var.office_wifi_key
+  key_version      = 1
+  mac_filter_state = "disabled"
+}
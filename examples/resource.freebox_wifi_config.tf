@@ -0,0 +1,14 @@
+resource "freebox_wifi_config" "example" {
+  enabled = true
+
+  planning = {
+    enabled = true
+    off_ranges = [
+      {
+        days  = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+        start = "01:00"
+        end   = "07:00"
+      },
+    ]
+  }
+}
@@ -0,0 +1,11 @@
+resource "freebox_wifi_guest_key" "front_desk" {
+  description   = "Front desk visitors"
+  duration      = "168h"
+  max_use_count = 20
+  access_type   = "net_only"
+}
+
+output "guest_wifi_qr_code" {
+  value     = freebox_wifi_guest_key.front_desk.qr_code_payload
+  sensitive = true
+}
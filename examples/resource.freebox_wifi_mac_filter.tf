@@ -0,0 +1,24 @@
+data "freebox_lan_interface_hosts" "pub" {
+  interface = "pub"
+}
+
+resource "freebox_wifi_mac_filter" "main" {
+  mode = "whitelist"
+
+  entries = setunion(
+    [
+      for host in data.freebox_lan_interface_hosts.pub.hosts : {
+        mac     = host.l2ident.id
+        type    = "whitelist"
+        comment = "Known host"
+      } if host.l2ident.type == "mac_address"
+    ],
+    [
+      {
+        mac     = "66:77:88:99:AA:BB"
+        type    = "blacklist"
+        comment = "Unmanaged IoT device"
+      },
+    ],
+  )
+}
@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nikolalohinski/free-go/client"
+)
+
+var _ datasource.DataSource = &connectionDataSource{}
+
+func NewConnectionDataSource() datasource.DataSource {
+	return &connectionDataSource{}
+}
+
+type connectionDataSource struct {
+	client client.Client
+}
+
+type connectionModel struct {
+	State         types.String `tfsdk:"state"`
+	Type          types.String `tfsdk:"type"`
+	Media         types.String `tfsdk:"media"`
+	IPv4          types.String `tfsdk:"ipv4"`
+	IPv6          types.String `tfsdk:"ipv6"`
+	BandwidthUp   types.Int64  `tfsdk:"bandwidth_up"`
+	BandwidthDown types.Int64  `tfsdk:"bandwidth_down"`
+	RateUp        types.Int64  `tfsdk:"rate_up"`
+	RateDown      types.Int64  `tfsdk:"rate_down"`
+	BytesUp       types.Int64  `tfsdk:"bytes_up"`
+	BytesDown     types.Int64  `tfsdk:"bytes_down"`
+}
+
+func (d *connectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection"
+}
+
+func (d *connectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the status of the Internet connection of the Freebox (state, public addresses, media, bandwidth and rates).",
+		Attributes: map[string]schema.Attribute{
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "State of the connection (`going_up`, `up`, `going_down` or `down`)",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the connection (`ethernet`, `rfc2684` or `pppoatm`)",
+			},
+			"media": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Media of the connection (`ftth`, `ethernet`, `xdsl` or `backup_4g`)",
+			},
+			"ipv4": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Public IPv4 address of the Freebox",
+			},
+			"ipv6": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Public IPv6 address of the Freebox",
+			},
+			"bandwidth_up": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Available upload bandwidth in bit/s",
+			},
+			"bandwidth_down": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Available download bandwidth in bit/s",
+			},
+			"rate_up": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Current upload rate in byte/s",
+			},
+			"rate_down": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Current download rate in byte/s",
+			},
+			"bytes_up": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total uploaded bytes since the last connection",
+			},
+			"bytes_down": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total downloaded bytes since the last connection",
+			},
+		},
+	}
+}
+
+func (d *connectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *connectionDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	status, err := d.client.GetConnectionStatus(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get connection status", fmt.Sprintf("Failed to get connection status: %s", err))
+		return
+	}
+
+	data := connectionModel{
+		State:         types.StringValue(status.State),
+		Type:          types.StringValue(status.Type),
+		Media:         types.StringValue(status.Media),
+		IPv4:          types.StringValue(status.IPv4),
+		IPv6:          types.StringValue(status.IPv6),
+		BandwidthUp:   types.Int64Value(status.BandwidthUp),
+		BandwidthDown: types.Int64Value(status.BandwidthDown),
+		RateUp:        types.Int64Value(status.RateUp),
+		RateDown:      types.Int64Value(status.RateDown),
+		BytesUp:       types.Int64Value(status.BytesUp),
+		BytesDown:     types.Int64Value(status.BytesDown),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
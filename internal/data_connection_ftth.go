@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nikolalohinski/free-go/client"
+)
+
+var _ datasource.DataSource = &connectionFTTHDataSource{}
+
+func NewConnectionFTTHDataSource() datasource.DataSource {
+	return &connectionFTTHDataSource{}
+}
+
+type connectionFTTHDataSource struct {
+	client client.Client
+}
+
+type connectionFTTHModel struct {
+	Link         types.Bool   `tfsdk:"link"`
+	SFPPresent   types.Bool   `tfsdk:"sfp_present"`
+	SFPAlimOK    types.Bool   `tfsdk:"sfp_alim_ok"`
+	SFPHasSignal types.Bool   `tfsdk:"sfp_has_signal"`
+	SFPModel     types.String `tfsdk:"sfp_model"`
+	SFPVendor    types.String `tfsdk:"sfp_vendor"`
+	SFPSerial    types.String `tfsdk:"sfp_serial"`
+	SFPPowerTx   types.Int64  `tfsdk:"sfp_pwr_tx"`
+	SFPPowerRx   types.Int64  `tfsdk:"sfp_pwr_rx"`
+}
+
+func (d *connectionFTTHDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_ftth"
+}
+
+func (d *connectionFTTHDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the status of the FTTH (fiber) connection of the Freebox and of its SFP module. Only available when the Freebox is connected through fiber.",
+		Attributes: map[string]schema.Attribute{
+			"link": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the fiber link is up",
+			},
+			"sfp_present": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether an SFP module is present",
+			},
+			"sfp_alim_ok": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the SFP module is powered",
+			},
+			"sfp_has_signal": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the SFP module receives a signal",
+			},
+			"sfp_model": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Model of the SFP module",
+			},
+			"sfp_vendor": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Vendor of the SFP module",
+			},
+			"sfp_serial": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Serial number of the SFP module",
+			},
+			"sfp_pwr_tx": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Transmitted power of the SFP module in hundredths of dBm",
+			},
+			"sfp_pwr_rx": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Received power of the SFP module in hundredths of dBm",
+			},
+		},
+	}
+}
+
+func (d *connectionFTTHDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *connectionFTTHDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ftth, err := d.client.GetConnectionFTTH(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get FTTH connection status", fmt.Sprintf("Failed to get FTTH connection status: %s", err))
+		return
+	}
+
+	data := connectionFTTHModel{
+		Link:         types.BoolValue(ftth.Link),
+		SFPPresent:   types.BoolValue(ftth.SFPPresent),
+		SFPAlimOK:    types.BoolValue(ftth.SFPAlimOK),
+		SFPHasSignal: types.BoolValue(ftth.SFPHasSignal),
+		SFPModel:     types.StringValue(ftth.SFPModel),
+		SFPVendor:    types.StringValue(ftth.SFPVendor),
+		SFPSerial:    types.StringValue(ftth.SFPSerial),
+		SFPPowerTx:   types.Int64Value(ftth.SFPPowerTx),
+		SFPPowerRx:   types.Int64Value(ftth.SFPPowerRx),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_connection_ftth" { ... }`, func() {
+	var (
+		config  string
+		resName string
+		ftth    freeboxTypes.ConnectionFTTH
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		status, err := freeboxClient.GetConnectionStatus(ctx)
+		Expect(err).To(BeNil())
+		if status.Media != "ftth" {
+			Skip("the Freebox is not connected through fiber")
+		}
+
+		ftth, err = freeboxClient.GetConnectionFTTH(ctx)
+		Expect(err).To(BeNil())
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			data "freebox_connection_ftth" "` + resName + `" {
+			}
+		`
+	})
+
+	It("should fetch the FTTH connection status", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							state := s.RootModule().Resources["data.freebox_connection_ftth."+resName].Primary.Attributes
+
+							Expect(state["link"]).To(Equal(fmt.Sprintf("%t", ftth.Link)))
+							Expect(state["sfp_present"]).To(Equal(fmt.Sprintf("%t", ftth.SFPPresent)))
+							Expect(state["sfp_has_signal"]).To(Equal(fmt.Sprintf("%t", ftth.SFPHasSignal)))
+							Expect(state["sfp_model"]).To(Equal(ftth.SFPModel))
+							Expect(state["sfp_vendor"]).To(Equal(ftth.SFPVendor))
+							Expect(state["sfp_serial"]).To(Equal(ftth.SFPSerial))
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+})
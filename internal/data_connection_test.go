@@ -0,0 +1,64 @@
+package internal_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_connection" { ... }`, func() {
+	var (
+		config  string
+		resName string
+		status  freeboxTypes.ConnectionStatus
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		status, err = freeboxClient.GetConnectionStatus(ctx)
+		Expect(err).To(BeNil())
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			data "freebox_connection" "` + resName + `" {
+			}
+		`
+	})
+
+	It("should fetch the connection status", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							state := s.RootModule().Resources["data.freebox_connection."+resName].Primary.Attributes
+
+							Expect(state["state"]).To(Equal(status.State))
+							Expect(state["type"]).To(Equal(status.Type))
+							Expect(state["media"]).To(Equal(status.Media))
+							Expect(state["ipv4"]).To(Equal(status.IPv4))
+							Expect(state["ipv6"]).To(Equal(status.IPv6))
+							Expect(state).To(HaveKey("bandwidth_up"))
+							Expect(state).To(HaveKey("bandwidth_down"))
+							Expect(state).To(HaveKey("rate_up"))
+							Expect(state).To(HaveKey("rate_down"))
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+})
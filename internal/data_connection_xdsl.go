@@ -0,0 +1,187 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var _ datasource.DataSource = &connectionXDSLDataSource{}
+
+func NewConnectionXDSLDataSource() datasource.DataSource {
+	return &connectionXDSLDataSource{}
+}
+
+type connectionXDSLDataSource struct {
+	client client.Client
+}
+
+type connectionXDSLModel struct {
+	Status     types.String `tfsdk:"status"`
+	Protocol   types.String `tfsdk:"protocol"`
+	Modulation types.String `tfsdk:"modulation"`
+	Uptime     types.Int64  `tfsdk:"uptime"`
+	Up         types.Object `tfsdk:"up"`
+	Down       types.Object `tfsdk:"down"`
+}
+
+// connectionXDSLStatsModel describes the statistics of one direction of the xDSL line.
+type connectionXDSLStatsModel struct {
+	MaxRate     types.Int64 `tfsdk:"maxrate"`
+	Rate        types.Int64 `tfsdk:"rate"`
+	SNR         types.Int64 `tfsdk:"snr"`
+	Attenuation types.Int64 `tfsdk:"attn"`
+	CRC         types.Int64 `tfsdk:"crc"`
+	FEC         types.Int64 `tfsdk:"fec"`
+	HEC         types.Int64 `tfsdk:"hec"`
+	ES          types.Int64 `tfsdk:"es"`
+	SES         types.Int64 `tfsdk:"ses"`
+}
+
+func (m connectionXDSLStatsModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"maxrate": types.Int64Type,
+		"rate":    types.Int64Type,
+		"snr":     types.Int64Type,
+		"attn":    types.Int64Type,
+		"crc":     types.Int64Type,
+		"fec":     types.Int64Type,
+		"hec":     types.Int64Type,
+		"es":      types.Int64Type,
+		"ses":     types.Int64Type,
+	}
+}
+
+func (m connectionXDSLStatsModel) DataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"maxrate": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "ATM max rate in kbit/s",
+		},
+		"rate": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "ATM rate in kbit/s",
+		},
+		"snr": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Signal noise ratio in dB",
+		},
+		"attn": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Line attenuation in dB",
+		},
+		"crc": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Count of CRC errors",
+		},
+		"fec": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Count of FEC errors",
+		},
+		"hec": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Count of HEC errors",
+		},
+		"es": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Count of errored seconds",
+		},
+		"ses": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Count of severely errored seconds",
+		},
+	}
+}
+
+func connectionXDSLStatsValue(stats freeboxTypes.ConnectionXDSLStats) basetypes.ObjectValue {
+	return basetypes.NewObjectValueMust(connectionXDSLStatsModel{}.AttrTypes(), map[string]attr.Value{
+		"maxrate": basetypes.NewInt64Value(stats.MaxRate),
+		"rate":    basetypes.NewInt64Value(stats.Rate),
+		"snr":     basetypes.NewInt64Value(stats.SNR),
+		"attn":    basetypes.NewInt64Value(stats.Attenuation),
+		"crc":     basetypes.NewInt64Value(stats.CRC),
+		"fec":     basetypes.NewInt64Value(stats.FEC),
+		"hec":     basetypes.NewInt64Value(stats.HEC),
+		"es":      basetypes.NewInt64Value(stats.ES),
+		"ses":     basetypes.NewInt64Value(stats.SES),
+	})
+}
+
+func (d *connectionXDSLDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_xdsl"
+}
+
+func (d *connectionXDSLDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the status and line statistics of the xDSL connection of the Freebox. Only available when the Freebox is connected through xDSL.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the line (e.g. `down`, `training`, `showtime`)",
+			},
+			"protocol": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Protocol of the line (e.g. `adsl2plus_a`, `vdsl2`)",
+			},
+			"modulation": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Modulation of the line (`adsl` or `vdsl`)",
+			},
+			"uptime": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Uptime of the line in seconds",
+			},
+			"up": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Statistics of the upstream direction",
+				Attributes:          connectionXDSLStatsModel{}.DataSourceAttributes(),
+			},
+			"down": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Statistics of the downstream direction",
+				Attributes:          connectionXDSLStatsModel{}.DataSourceAttributes(),
+			},
+		},
+	}
+}
+
+func (d *connectionXDSLDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *connectionXDSLDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	xdsl, err := d.client.GetConnectionXDSL(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get xDSL connection status", fmt.Sprintf("Failed to get xDSL connection status: %s", err))
+		return
+	}
+
+	data := connectionXDSLModel{
+		Status:     types.StringValue(xdsl.Status.Status),
+		Protocol:   types.StringValue(xdsl.Status.Protocol),
+		Modulation: types.StringValue(xdsl.Status.Modulation),
+		Uptime:     types.Int64Value(xdsl.Status.Uptime),
+		Up:         connectionXDSLStatsValue(xdsl.Up),
+		Down:       connectionXDSLStatsValue(xdsl.Down),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
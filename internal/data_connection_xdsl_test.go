@@ -0,0 +1,65 @@
+package internal_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_connection_xdsl" { ... }`, func() {
+	var (
+		config  string
+		resName string
+		xdsl    freeboxTypes.ConnectionXDSL
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		status, err := freeboxClient.GetConnectionStatus(ctx)
+		Expect(err).To(BeNil())
+		if status.Media != "xdsl" {
+			Skip("the Freebox is not connected through xDSL")
+		}
+
+		xdsl, err = freeboxClient.GetConnectionXDSL(ctx)
+		Expect(err).To(BeNil())
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			data "freebox_connection_xdsl" "` + resName + `" {
+			}
+		`
+	})
+
+	It("should fetch the xDSL connection status", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							state := s.RootModule().Resources["data.freebox_connection_xdsl."+resName].Primary.Attributes
+
+							Expect(state["status"]).To(Equal(xdsl.Status.Status))
+							Expect(state["protocol"]).To(Equal(xdsl.Status.Protocol))
+							Expect(state["modulation"]).To(Equal(xdsl.Status.Modulation))
+							Expect(state).To(HaveKey("up.rate"))
+							Expect(state).To(HaveKey("down.rate"))
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+})
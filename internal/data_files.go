@@ -0,0 +1,244 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	go_path "path"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ datasource.DataSource                   = &filesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &filesDataSource{}
+)
+
+func NewFilesDataSource() datasource.DataSource {
+	return &filesDataSource{}
+}
+
+type filesDataSource struct {
+	client client.Client
+}
+
+type filesModel struct {
+	Path      types.String `tfsdk:"path"`
+	Recursive types.Bool   `tfsdk:"recursive"`
+	MaxDepth  types.Int64  `tfsdk:"max_depth"`
+	Glob      types.String `tfsdk:"glob"`
+	Regex     types.String `tfsdk:"regex"`
+	Files     types.List   `tfsdk:"files"`
+}
+
+type fileModel struct {
+	Name       types.String      `tfsdk:"name"`
+	Path       types.String      `tfsdk:"path"`
+	Type       types.String      `tfsdk:"type"`
+	Size       types.Int64       `tfsdk:"size"`
+	ModifiedAt timetypes.RFC3339 `tfsdk:"modified_at"`
+	MimeType   types.String      `tfsdk:"mimetype"`
+}
+
+func (m fileModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"path":        types.StringType,
+		"type":        types.StringType,
+		"size":        types.Int64Type,
+		"modified_at": timetypes.RFC3339Type{},
+		"mimetype":    types.StringType,
+	}
+}
+
+func (d *filesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files"
+}
+
+func (d *filesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the files of a directory on the Freebox, optionally recursively and filtered by name or path.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path to the directory to list on the Freebox (e.g. `/Freebox/VMs`)",
+				Validators: []validator.String{
+					models.FilePathValidator(path.Root("path")),
+				},
+			},
+			"recursive": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to list the content of the sub-directories (default: `false`)",
+			},
+			"max_depth": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum depth of a recursive listing, `1` only lists the direct children of the directory. Requires `recursive` to be `true` (default: unlimited)",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("recursive")),
+				},
+			},
+			"glob": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the files whose name matches this shell pattern (e.g. `*.qcow2`)",
+			},
+			"regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the files whose full path matches this regular expression (e.g. `^/Freebox/VMs/.*\\.iso$`)",
+			},
+			"files": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of files, directories included",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the file",
+						},
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Full path to the file on the Freebox",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the file (`file` or `dir`)",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size of the file in bytes",
+						},
+						"modified_at": schema.StringAttribute{
+							Computed:            true,
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "Last modification date of the file",
+						},
+						"mimetype": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "MIME type of the file (e.g. `application/x-qemu-disk`)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *filesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data filesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MaxDepth.IsNull() || data.Recursive.IsUnknown() {
+		return
+	}
+
+	if !data.Recursive.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_depth"),
+			"Invalid max depth",
+			"A maximum depth is only allowed for a recursive listing, set recursive to true",
+		)
+	}
+}
+
+func (d *filesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *filesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model filesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	glob := model.Glob.ValueString()
+	if _, err := go_path.Match(glob, ""); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("glob"), "Invalid glob pattern", fmt.Sprintf("Pattern: %s, Error: %s", glob, err.Error()))
+		return
+	}
+
+	var pattern *regexp.Regexp
+	if !model.Regex.IsNull() {
+		var err error
+		if pattern, err = regexp.Compile(model.Regex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("regex"), "Invalid regular expression", fmt.Sprintf("Expression: %s, Error: %s", model.Regex.ValueString(), err.Error()))
+			return
+		}
+	}
+
+	maxDepth := int64(1)
+	if model.Recursive.ValueBool() {
+		maxDepth = model.MaxDepth.ValueInt64() // Zero when not set, for an unlimited depth
+	}
+
+	files, err := listFiles(ctx, d.client, model.Path.ValueString(), 1, maxDepth)
+	if err != nil {
+		if errors.Is(err, client.ErrPathNotFound) {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Directory not found", fmt.Sprintf("Path: %s", model.Path.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Failed to list files", fmt.Sprintf("Path: %s, Error: %s", model.Path.ValueString(), err.Error()))
+		return
+	}
+
+	attrTypes := fileModel{}.attrTypes()
+	items := make([]attr.Value, 0, len(files))
+	for _, file := range files {
+		if glob != "" {
+			if matched, _ := go_path.Match(glob, file.Name); !matched {
+				continue
+			}
+		}
+		if pattern != nil && !pattern.MatchString(string(file.Path)) {
+			continue
+		}
+
+		items = append(items, basetypes.NewObjectValueMust(attrTypes, map[string]attr.Value{
+			"name":        types.StringValue(file.Name),
+			"path":        types.StringValue(string(file.Path)),
+			"type":        types.StringValue(string(file.Type)),
+			"size":        types.Int64Value(int64(file.SizeBytes)),
+			"modified_at": timetypes.NewRFC3339TimeValue(time.Unix(int64(file.Modification), 0).UTC()),
+			"mimetype":    types.StringValue(file.MimeType),
+		}))
+	}
+
+	var diags diag.Diagnostics
+
+	model.Files, diags = basetypes.NewListValue(types.ObjectType{AttrTypes: attrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
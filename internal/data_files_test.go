@@ -0,0 +1,110 @@
+package internal_test
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe(`data "freebox_files" { ... }`, func() {
+	var (
+		config  string
+		resName string
+	)
+
+	BeforeEach(func() {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+	})
+
+	Context("listing a directory", func() {
+		JustBeforeEach(func() {
+			config = providerBlock + `
+				data "freebox_files" "` + resName + `" {
+					path = "` + root + `/` + existingDisk.directory + `"
+					glob = "*.qcow2"
+				}
+			`
+		})
+
+		It("should return the matching files", func() {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckTypeSetElemNestedAttrs("data.freebox_files."+resName, "files.*", map[string]string{
+								"name": existingDisk.filename,
+								"type": "file",
+							}),
+						),
+					},
+				},
+			})
+		})
+	})
+
+	Context("listing the root directory", func() {
+		var recursive string
+
+		JustBeforeEach(func() {
+			config = providerBlock + `
+				data "freebox_files" "` + resName + `" {
+					path      = "` + root + `"
+					recursive = ` + recursive + `
+					max_depth = 2
+					regex     = "/` + strings.ReplaceAll(existingDisk.filename, ".", `\\.`) + `$"
+				}
+			`
+		})
+
+		Context("without recursion", func() {
+			BeforeEach(func() {
+				recursive = "false"
+			})
+
+			It("should reject the max depth and not return the files of the sub-directories", func() {
+				resource.UnitTest(GinkgoT(), resource.TestCase{
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config:      config,
+							ExpectError: regexp.MustCompile(`Invalid max depth`),
+						},
+						{
+							Config: strings.Replace(config, "max_depth = 2", "", 1),
+							Check: resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("data.freebox_files."+resName, "files.#", "0"),
+							),
+						},
+					},
+				})
+			})
+		})
+
+		Context("with recursion", func() {
+			BeforeEach(func() {
+				recursive = "true"
+			})
+
+			It("should return the files of the sub-directories", func() {
+				resource.UnitTest(GinkgoT(), resource.TestCase{
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: config,
+							Check: resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("data.freebox_files."+resName, "files.#", "1"),
+								resource.TestCheckResourceAttr("data.freebox_files."+resName, "files.0.name", existingDisk.filename),
+							),
+						},
+					},
+				})
+			})
+		})
+	})
+})
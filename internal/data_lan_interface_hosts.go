@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/nikolalohinski/free-go/client"
-	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
 )
 
 var _ datasource.DataSource = &LanInterfaceHostsDataSource{}
@@ -44,7 +43,7 @@ func (a *LanInterfaceHostsDataSource) Schema(ctx context.Context, req datasource
 				Computed:            true,
 				MarkdownDescription: "List of hosts",
 				ElementType:         types.ObjectType{
-					AttrTypes: models.LanHostL2IdentModel{}.AttrTypes(),
+					AttrTypes: LanInterfaceHostDataSourceModel{}.AttrTypes(),
 				},
 			},
 		},
@@ -92,7 +91,7 @@ func (a *LanInterfaceHostsDataSource) Read(ctx context.Context, req datasource.R
 		hostsElements = append(hostsElements, hostModel.ToObjectValue())
 	}
 	data.Hosts = basetypes.NewSetValueMust(basetypes.ObjectType{
-		AttrTypes: models.LanHostL2IdentModel{}.AttrTypes(),
+		AttrTypes: LanInterfaceHostDataSourceModel{}.AttrTypes(),
 	}, hostsElements)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,290 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ datasource.DataSource = &rrdDataSource{}
+)
+
+// rrdTimeField is the field of the RRD data points holding their UNIX timestamp.
+const rrdTimeField = "time"
+
+// rrdDatabases lists the RRD databases of the Freebox.
+var rrdDatabases = []string{
+	"net",
+	"temp",
+	"dsl",
+	"switch",
+}
+
+func NewRRDDataSource() datasource.DataSource {
+	return &rrdDataSource{}
+}
+
+type rrdDataSource struct {
+	client client.Client
+}
+
+type rrdModel struct {
+	Database   types.String         `tfsdk:"db"`
+	Fields     types.List           `tfsdk:"fields"`
+	Start      timetypes.RFC3339    `tfsdk:"start"`
+	End        timetypes.RFC3339    `tfsdk:"end"`
+	Precision  types.Int64          `tfsdk:"precision"`
+	Resolution timetypes.GoDuration `tfsdk:"resolution"`
+	Series     types.Map            `tfsdk:"series"`
+}
+
+// rrdPoint is a value of a field of the RRD at a given UNIX timestamp.
+type rrdPoint struct {
+	timestamp int64
+	value     int64
+}
+
+// downsampleRRDPoints averages the chronologically ordered points over buckets of step seconds, each
+// resulting point being timestamped with the start of its bucket.
+func downsampleRRDPoints(points []rrdPoint, step int64) []rrdPoint {
+	result := make([]rrdPoint, 0, len(points))
+
+	var sum, count int64
+	for i, point := range points {
+		sum += point.value
+		count++
+
+		bucket := point.timestamp - point.timestamp%step
+		if i+1 < len(points) && points[i+1].timestamp-points[i+1].timestamp%step == bucket {
+			continue
+		}
+
+		result = append(result, rrdPoint{timestamp: bucket, value: sum / count})
+		sum, count = 0, 0
+	}
+
+	return result
+}
+
+func rrdPointAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"timestamp": types.Int64Type,
+		"value":     types.Int64Type,
+	}
+}
+
+func (v *rrdDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rrd"
+}
+
+func (v *rrdDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the history of the statistics of the Freebox from its RRD (round-robin database) for a time window, as one time series per field.",
+		Attributes: map[string]schema.Attribute{
+			"db": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Database to query: `net` (bandwidth and rates in byte/s), `temp` (temperatures and fan speed), `dsl` (xDSL rates and noise margins) or `switch` (rates of the switch ports)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(rrdDatabases...),
+				},
+			},
+			"fields": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Fields of the database to return (e.g. `rate_down` or `cpum`). If not set, all the fields are returned",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.NoneOf(rrdTimeField)),
+				},
+			},
+			"start": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Start of the time window as an RFC3339 timestamp. If not set, the Freebox picks it",
+			},
+			"end": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "End of the time window as an RFC3339 timestamp. If not set, the current time is used",
+			},
+			"precision": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Factor the values are multiplied by before being rounded to integers, to keep decimals (e.g. `10` to keep one decimal of the temperatures). If not set, the values are not scaled",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"resolution": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+				MarkdownDescription: "Time between two points of the series (e.g. `10m`), the values being averaged over each period. The Freebox has no such setting: the resolution of the stored data gets coarser as the time window gets older, and this can not make it finer. If not set, the points are returned as stored",
+			},
+			"series": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time series indexed by field, each one being a list of points with a `timestamp` (UNIX seconds) and a `value`",
+				ElementType: types.ListType{
+					ElemType: types.ObjectType{AttrTypes: rrdPointAttrTypes()},
+				},
+			},
+		},
+	}
+}
+
+func (v *rrdDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = client
+}
+
+func (v *rrdDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model rrdModel
+
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	request := freeboxTypes.RRDRequest{
+		DB:        model.Database.ValueString(),
+		Precision: model.Precision.ValueInt64(),
+	}
+
+	if !model.Fields.IsNull() {
+		if diags := model.Fields.ElementsAs(ctx, &request.Fields, false); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	if !model.Start.IsNull() {
+		start, diags := model.Start.ValueRFC3339Time()
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		request.DateStart = start.Unix()
+	}
+
+	if !model.End.IsNull() {
+		end, diags := model.End.ValueRFC3339Time()
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		request.DateEnd = end.Unix()
+	}
+
+	if request.DateStart != 0 && request.DateEnd != 0 && request.DateStart >= request.DateEnd {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start"),
+			"Invalid time window",
+			"The start of the time window must be before its end",
+		)
+		return
+	}
+
+	var step int64
+	if !model.Resolution.IsNull() {
+		resolution, diags := model.Resolution.ValueGoDuration()
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if resolution < time.Second {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resolution"),
+				"Invalid resolution",
+				"The resolution must be at least one second",
+			)
+			return
+		}
+		step = int64(resolution.Seconds())
+	}
+
+	data, err := v.client.GetRRDData(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get RRD data",
+			fmt.Sprintf("Database: %s, Error: %s", request.DB, err),
+		)
+		return
+	}
+
+	points := map[string][]rrdPoint{}
+	for _, field := range request.Fields {
+		points[field] = []rrdPoint{}
+	}
+	for _, point := range data.Data {
+		for field, value := range point {
+			if field == rrdTimeField {
+				continue
+			}
+			points[field] = append(points[field], rrdPoint{timestamp: point[rrdTimeField], value: value})
+		}
+	}
+
+	pointType := types.ObjectType{AttrTypes: rrdPointAttrTypes()}
+
+	series := make(map[string]attr.Value, len(points))
+	for field, fieldPoints := range points {
+		if step > 0 {
+			fieldPoints = downsampleRRDPoints(fieldPoints, step)
+		}
+
+		values := make([]attr.Value, 0, len(fieldPoints))
+		for _, point := range fieldPoints {
+			values = append(values, basetypes.NewObjectValueMust(pointType.AttrTypes, map[string]attr.Value{
+				"timestamp": basetypes.NewInt64Value(point.timestamp),
+				"value":     basetypes.NewInt64Value(point.value),
+			}))
+		}
+		series[field] = basetypes.NewListValueMust(pointType, values)
+	}
+
+	var diags diag.Diagnostics
+
+	model.Series, diags = basetypes.NewMapValue(types.ListType{ElemType: pointType}, series)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The time window picked by the Freebox is only reported when it was not set in the configuration
+	if model.Start.IsNull() {
+		model.Start = timetypes.NewRFC3339TimeValue(time.Unix(data.DateStart, 0).UTC())
+	}
+	if model.End.IsNull() {
+		model.End = timetypes.NewRFC3339TimeValue(time.Unix(data.DateEnd, 0).UTC())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
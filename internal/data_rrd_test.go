@@ -0,0 +1,98 @@
+package internal_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_rrd" { ... }`, func() {
+	var (
+		config  string
+		resName string
+	)
+
+	BeforeEach(func() {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			data "freebox_rrd" "` + resName + `" {
+				db        = "net"
+				fields    = ["rate_up", "rate_down"]
+				precision = 10
+			}
+		`
+	})
+
+	It("should return one time series per requested field", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.freebox_rrd."+resName, "series.%", "2"),
+						resource.TestCheckResourceAttrSet("data.freebox_rrd."+resName, "start"),
+						resource.TestCheckResourceAttrSet("data.freebox_rrd."+resName, "end"),
+						func(s *terraform.State) error {
+							state := s.RootModule().Resources["data.freebox_rrd."+resName].Primary.Attributes
+
+							Expect(state).To(HaveKey("series.rate_up.#"))
+							Expect(state).To(HaveKey("series.rate_down.#"))
+							Expect(state["series.rate_up.#"]).To(Equal(state["series.rate_down.#"]))
+							Expect(state["series.rate_up.#"]).ToNot(Equal("0"))
+							Expect(state).To(HaveKey("series.rate_up.0.timestamp"))
+							Expect(state).To(HaveKey("series.rate_up.0.value"))
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+
+	It("should average the points over the requested resolution", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerBlock + `
+						data "freebox_rrd" "` + resName + `" {
+							db         = "net"
+							fields     = ["rate_down"]
+							resolution = "1h"
+						}
+					`,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.freebox_rrd."+resName, "resolution", "1h"),
+						func(s *terraform.State) error {
+							state := s.RootModule().Resources["data.freebox_rrd."+resName].Primary.Attributes
+
+							count, err := strconv.Atoi(state["series.rate_down.#"])
+							Expect(err).To(BeNil())
+							Expect(count).ToNot(BeZero())
+
+							for i := 0; i < count; i++ {
+								timestamp, err := strconv.ParseInt(state[fmt.Sprintf("series.rate_down.%d.timestamp", i)], 10, 64)
+								Expect(err).To(BeNil())
+								Expect(timestamp % 3600).To(BeZero())
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+})
@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+)
+
+var _ datasource.DataSource = &storageDisksDataSource{}
+
+func NewStorageDisksDataSource() datasource.DataSource {
+	return &storageDisksDataSource{}
+}
+
+type storageDisksDataSource struct {
+	client client.Client
+}
+
+type storageDisksModel struct {
+	Disks types.List `tfsdk:"disks"`
+}
+
+type storageDiskModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Type         types.String `tfsdk:"type"`
+	State        types.String `tfsdk:"state"`
+	Model        types.String `tfsdk:"model"`
+	Serial       types.String `tfsdk:"serial"`
+	Firmware     types.String `tfsdk:"firmware"`
+	Temperature  types.Int64  `tfsdk:"temperature"`
+	Spinning     types.Bool   `tfsdk:"spinning"`
+	TotalBytes   types.Int64  `tfsdk:"total_bytes"`
+	PartitionIDs types.List   `tfsdk:"partition_ids"`
+}
+
+func (m storageDiskModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.Int64Type,
+		"type":          types.StringType,
+		"state":         types.StringType,
+		"model":         types.StringType,
+		"serial":        types.StringType,
+		"firmware":      types.StringType,
+		"temperature":   types.Int64Type,
+		"spinning":      types.BoolType,
+		"total_bytes":   types.Int64Type,
+		"partition_ids": types.ListType{ElemType: types.Int64Type},
+	}
+}
+
+func (d *storageDisksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_disks"
+}
+
+func (d *storageDisksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the disks connected to the Freebox (internal, USB or SATA).",
+		Attributes: map[string]schema.Attribute{
+			"disks": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of disks",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Disk identifier",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the disk (`internal`, `usb` or `sata`)",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "State of the disk (e.g. `enabled`, `disabled`, `formatting`, `error`)",
+						},
+						"model": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Model of the disk",
+						},
+						"serial": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Serial number of the disk",
+						},
+						"firmware": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Firmware version of the disk",
+						},
+						"temperature": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Temperature of the disk in °C, `0` when unknown",
+						},
+						"spinning": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the disk is spinning",
+						},
+						"total_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size of the disk in bytes",
+						},
+						"partition_ids": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "Identifiers of the partitions of the disk, as listed by the `freebox_storage_partitions` data source",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *storageDisksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *storageDisksDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	disks, err := d.client.ListStorageDisks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list storage disks", fmt.Sprintf("Failed to list storage disks: %s", err))
+		return
+	}
+
+	attrTypes := storageDiskModel{}.attrTypes()
+	items := make([]attr.Value, len(disks))
+	for i, disk := range disks {
+		partitionIDs := make([]attr.Value, len(disk.Partitions))
+		for j, partition := range disk.Partitions {
+			partitionIDs[j] = types.Int64Value(partition.ID)
+		}
+
+		items[i] = basetypes.NewObjectValueMust(attrTypes, map[string]attr.Value{
+			"id":            types.Int64Value(disk.ID),
+			"type":          types.StringValue(disk.Type),
+			"state":         types.StringValue(disk.State),
+			"model":         types.StringValue(disk.Model),
+			"serial":        types.StringValue(disk.Serial),
+			"firmware":      types.StringValue(disk.Firmware),
+			"temperature":   types.Int64Value(disk.Temp),
+			"spinning":      types.BoolValue(disk.Spinning),
+			"total_bytes":   types.Int64Value(disk.TotalBytes),
+			"partition_ids": basetypes.NewListValueMust(types.Int64Type, partitionIDs),
+		})
+	}
+
+	list, diags := basetypes.NewListValue(types.ObjectType{AttrTypes: attrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &storageDisksModel{Disks: list})...)
+}
@@ -0,0 +1,70 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_storage_disks" { ... }`, func() {
+	var (
+		config  string
+		resName string
+		disks   []freeboxTypes.StorageDisk
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		disks, err = freeboxClient.ListStorageDisks(ctx)
+		Expect(err).To(BeNil())
+		Expect(disks).ToNot(BeEmpty())
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			data "freebox_storage_disks" "` + resName + `" {
+			}
+		`
+	})
+
+	It("should list the storage disks", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.freebox_storage_disks."+resName,
+							"disks.#",
+							fmt.Sprintf("%d", len(disks)),
+						),
+						func(s *terraform.State) error {
+							state := s.RootModule().Resources["data.freebox_storage_disks."+resName].Primary.Attributes
+
+							for i, disk := range disks {
+								Expect(state[fmt.Sprintf("disks.%d.id", i)]).To(Equal(fmt.Sprintf("%d", disk.ID)))
+								Expect(state[fmt.Sprintf("disks.%d.type", i)]).To(Equal(disk.Type))
+								Expect(state[fmt.Sprintf("disks.%d.model", i)]).To(Equal(disk.Model))
+								Expect(state[fmt.Sprintf("disks.%d.serial", i)]).To(Equal(disk.Serial))
+								Expect(state[fmt.Sprintf("disks.%d.total_bytes", i)]).To(Equal(fmt.Sprintf("%d", disk.TotalBytes)))
+								Expect(state[fmt.Sprintf("disks.%d.partition_ids.#", i)]).To(Equal(fmt.Sprintf("%d", len(disk.Partitions))))
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+})
@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+)
+
+var _ datasource.DataSource = &storagePartitionsDataSource{}
+
+func NewStoragePartitionsDataSource() datasource.DataSource {
+	return &storagePartitionsDataSource{}
+}
+
+type storagePartitionsDataSource struct {
+	client client.Client
+}
+
+type storagePartitionsModel struct {
+	DiskID     types.Int64 `tfsdk:"disk_id"`
+	Partitions types.List  `tfsdk:"partitions"`
+}
+
+type storagePartitionModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	DiskID     types.Int64  `tfsdk:"disk_id"`
+	Label      types.String `tfsdk:"label"`
+	State      types.String `tfsdk:"state"`
+	FSType     types.String `tfsdk:"fstype"`
+	Path       types.String `tfsdk:"path"`
+	TotalBytes types.Int64  `tfsdk:"total_bytes"`
+	UsedBytes  types.Int64  `tfsdk:"used_bytes"`
+	FreeBytes  types.Int64  `tfsdk:"free_bytes"`
+}
+
+func (m storagePartitionModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.Int64Type,
+		"disk_id":     types.Int64Type,
+		"label":       types.StringType,
+		"state":       types.StringType,
+		"fstype":      types.StringType,
+		"path":        types.StringType,
+		"total_bytes": types.Int64Type,
+		"used_bytes":  types.Int64Type,
+		"free_bytes":  types.Int64Type,
+	}
+}
+
+func (d *storagePartitionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_partitions"
+}
+
+func (d *storagePartitionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the partitions of the disks connected to the Freebox, with their filesystem, mount path and usage.",
+		Attributes: map[string]schema.Attribute{
+			"disk_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the partitions of this disk, as listed by the `freebox_storage_disks` data source",
+			},
+			"partitions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of partitions",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Partition identifier",
+						},
+						"disk_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Identifier of the disk of the partition",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Label of the partition",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "State of the partition (e.g. `mounted`, `umounted`, `checking`, `error`)",
+						},
+						"fstype": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Filesystem of the partition (e.g. `ext4`, `ntfs`, `vfat`)",
+						},
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Mount path of the partition on the Freebox (e.g. `/Freebox`)",
+						},
+						"total_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size of the partition in bytes",
+						},
+						"used_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Used space of the partition in bytes",
+						},
+						"free_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Free space of the partition in bytes",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *storagePartitionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *storagePartitionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model storagePartitionsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	partitions, err := d.client.ListStoragePartitions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list storage partitions", fmt.Sprintf("Failed to list storage partitions: %s", err))
+		return
+	}
+
+	attrTypes := storagePartitionModel{}.attrTypes()
+	items := make([]attr.Value, 0, len(partitions))
+	for _, partition := range partitions {
+		if !model.DiskID.IsNull() && partition.DiskID != model.DiskID.ValueInt64() {
+			continue
+		}
+
+		items = append(items, basetypes.NewObjectValueMust(attrTypes, map[string]attr.Value{
+			"id":          types.Int64Value(partition.ID),
+			"disk_id":     types.Int64Value(partition.DiskID),
+			"label":       types.StringValue(partition.Label),
+			"state":       types.StringValue(partition.State),
+			"fstype":      types.StringValue(partition.FSType),
+			"path":        types.StringValue(string(partition.Path)),
+			"total_bytes": types.Int64Value(partition.TotalBytes),
+			"used_bytes":  types.Int64Value(partition.UsedBytes),
+			"free_bytes":  types.Int64Value(partition.FreeBytes),
+		}))
+	}
+
+	var diags diag.Diagnostics
+
+	model.Partitions, diags = basetypes.NewListValue(types.ObjectType{AttrTypes: attrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,113 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_storage_partitions" { ... }`, func() {
+	var (
+		config     string
+		resName    string
+		partitions []freeboxTypes.StoragePartition
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		partitions, err = freeboxClient.ListStoragePartitions(ctx)
+		Expect(err).To(BeNil())
+		Expect(partitions).ToNot(BeEmpty())
+	})
+
+	Context("without any filter", func() {
+		JustBeforeEach(func() {
+			config = providerBlock + `
+				data "freebox_storage_partitions" "` + resName + `" {
+				}
+			`
+		})
+
+		It("should list all the partitions", func(ctx SpecContext) {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"data.freebox_storage_partitions."+resName,
+								"partitions.#",
+								fmt.Sprintf("%d", len(partitions)),
+							),
+							func(s *terraform.State) error {
+								state := s.RootModule().Resources["data.freebox_storage_partitions."+resName].Primary.Attributes
+
+								for i, partition := range partitions {
+									Expect(state[fmt.Sprintf("partitions.%d.id", i)]).To(Equal(fmt.Sprintf("%d", partition.ID)))
+									Expect(state[fmt.Sprintf("partitions.%d.disk_id", i)]).To(Equal(fmt.Sprintf("%d", partition.DiskID)))
+									Expect(state[fmt.Sprintf("partitions.%d.fstype", i)]).To(Equal(partition.FSType))
+									Expect(state[fmt.Sprintf("partitions.%d.path", i)]).To(Equal(string(partition.Path)))
+									Expect(state[fmt.Sprintf("partitions.%d.total_bytes", i)]).To(Equal(fmt.Sprintf("%d", partition.TotalBytes)))
+								}
+
+								return nil
+							},
+						),
+					},
+				},
+			})
+		})
+	})
+
+	Context("with a disk filter", func() {
+		var expectedCount int
+
+		JustBeforeEach(func() {
+			expectedCount = 0
+			for _, partition := range partitions {
+				if partition.DiskID == partitions[0].DiskID {
+					expectedCount++
+				}
+			}
+
+			config = providerBlock + fmt.Sprintf(`
+				data "freebox_storage_partitions" "%s" {
+					disk_id = %d
+				}
+			`, resName, partitions[0].DiskID)
+		})
+
+		It("should only list the partitions of the disk", func(ctx SpecContext) {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"data.freebox_storage_partitions."+resName,
+								"partitions.#",
+								fmt.Sprintf("%d", expectedCount),
+							),
+							resource.TestCheckResourceAttr(
+								"data.freebox_storage_partitions."+resName,
+								"partitions.0.disk_id",
+								fmt.Sprintf("%d", partitions[0].DiskID),
+							),
+						),
+					},
+				},
+			})
+		})
+	})
+})
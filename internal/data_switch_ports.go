@@ -0,0 +1,223 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+)
+
+var _ datasource.DataSource = &switchPortsDataSource{}
+
+func NewSwitchPortsDataSource() datasource.DataSource {
+	return &switchPortsDataSource{}
+}
+
+type switchPortsDataSource struct {
+	client client.Client
+}
+
+type switchPortsModel struct {
+	Ports types.List `tfsdk:"ports"`
+}
+
+type switchPortStatusModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Link      types.String `tfsdk:"link"`
+	Mode      types.String `tfsdk:"mode"`
+	Speed     types.String `tfsdk:"speed"`
+	Duplex    types.String `tfsdk:"duplex"`
+	Hosts     types.List   `tfsdk:"hosts"`
+	RxBytes   types.Int64  `tfsdk:"rx_bytes"`
+	RxPackets types.Int64  `tfsdk:"rx_packets"`
+	RxErrors  types.Int64  `tfsdk:"rx_errors"`
+	TxBytes   types.Int64  `tfsdk:"tx_bytes"`
+	TxPackets types.Int64  `tfsdk:"tx_packets"`
+	TxErrors  types.Int64  `tfsdk:"tx_errors"`
+}
+
+func (m switchPortStatusModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.Int64Type,
+		"name":       types.StringType,
+		"link":       types.StringType,
+		"mode":       types.StringType,
+		"speed":      types.StringType,
+		"duplex":     types.StringType,
+		"hosts":      types.ListType{ElemType: types.ObjectType{AttrTypes: switchPortHostModel{}.attrTypes()}},
+		"rx_bytes":   types.Int64Type,
+		"rx_packets": types.Int64Type,
+		"rx_errors":  types.Int64Type,
+		"tx_bytes":   types.Int64Type,
+		"tx_packets": types.Int64Type,
+		"tx_errors":  types.Int64Type,
+	}
+}
+
+type switchPortHostModel struct {
+	Mac      types.String `tfsdk:"mac"`
+	Hostname types.String `tfsdk:"hostname"`
+}
+
+func (m switchPortHostModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"mac":      types.StringType,
+		"hostname": types.StringType,
+	}
+}
+
+func (d *switchPortsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_ports"
+}
+
+func (d *switchPortsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the Ethernet ports of the switch of the Freebox with their link status, the hosts connected to them and their traffic counters.",
+		Attributes: map[string]schema.Attribute{
+			"ports": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of switch ports",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Port identifier",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Port name (e.g. `Ethernet 1`)",
+						},
+						"link": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Link state of the port (`up` or `down`)",
+						},
+						"mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Negotiated mode of the link (e.g. `1000BaseT-FD`)",
+						},
+						"speed": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Speed of the link in Mbit/s (e.g. `1000`)",
+						},
+						"duplex": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Duplex of the link (`half` or `full`)",
+						},
+						"hosts": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Hosts seen on the port",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"mac": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "MAC address of the host",
+									},
+									"hostname": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Name of the host",
+									},
+								},
+							},
+						},
+						"rx_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of bytes received",
+						},
+						"rx_packets": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of packets received",
+						},
+						"rx_errors": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of erroneous packets received",
+						},
+						"tx_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of bytes transmitted",
+						},
+						"tx_packets": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of packets transmitted",
+						},
+						"tx_errors": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of packets that failed to be transmitted",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *switchPortsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *switchPortsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ports, err := d.client.ListSwitchPortStatuses(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list switch ports", fmt.Sprintf("Failed to list switch ports: %s", err))
+		return
+	}
+
+	attrTypes := switchPortStatusModel{}.attrTypes()
+	hostAttrTypes := switchPortHostModel{}.attrTypes()
+	items := make([]attr.Value, len(ports))
+	for i, port := range ports {
+		stats, err := d.client.GetSwitchPortStats(ctx, port.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get switch port statistics", fmt.Sprintf("Failed to get statistics of switch port %d: %s", port.ID, err))
+			return
+		}
+
+		hosts := make([]attr.Value, len(port.MacList))
+		for j, host := range port.MacList {
+			hosts[j] = basetypes.NewObjectValueMust(hostAttrTypes, map[string]attr.Value{
+				"mac":      types.StringValue(host.Mac),
+				"hostname": types.StringValue(host.Hostname),
+			})
+		}
+
+		items[i] = basetypes.NewObjectValueMust(attrTypes, map[string]attr.Value{
+			"id":         types.Int64Value(port.ID),
+			"name":       types.StringValue(port.Name),
+			"link":       types.StringValue(port.Link),
+			"mode":       types.StringValue(port.Mode),
+			"speed":      types.StringValue(port.Speed),
+			"duplex":     types.StringValue(port.Duplex),
+			"hosts":      basetypes.NewListValueMust(types.ObjectType{AttrTypes: hostAttrTypes}, hosts),
+			"rx_bytes":   types.Int64Value(stats.RxGoodBytes),
+			"rx_packets": types.Int64Value(stats.RxGoodPackets),
+			"rx_errors":  types.Int64Value(stats.RxErrPackets),
+			"tx_bytes":   types.Int64Value(stats.TxBytes),
+			"tx_packets": types.Int64Value(stats.TxPackets),
+			"tx_errors":  types.Int64Value(stats.TxErrPackets),
+		})
+	}
+
+	list, diags := basetypes.NewListValue(types.ObjectType{AttrTypes: attrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &switchPortsModel{Ports: list})...)
+}
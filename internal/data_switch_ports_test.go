@@ -0,0 +1,69 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_switch_ports" { ... }`, func() {
+	var (
+		config  string
+		resName string
+		ports   []freeboxTypes.SwitchPortStatus
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		ports, err = freeboxClient.ListSwitchPortStatuses(ctx)
+		Expect(err).To(BeNil())
+		Expect(ports).ToNot(BeEmpty())
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			data "freebox_switch_ports" "` + resName + `" {
+			}
+		`
+	})
+
+	It("should list the switch ports", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(
+							"data.freebox_switch_ports."+resName,
+							"ports.#",
+							fmt.Sprintf("%d", len(ports)),
+						),
+						func(s *terraform.State) error {
+							state := s.RootModule().Resources["data.freebox_switch_ports."+resName].Primary.Attributes
+
+							for i, port := range ports {
+								Expect(state[fmt.Sprintf("ports.%d.id", i)]).To(Equal(fmt.Sprintf("%d", port.ID)))
+								Expect(state[fmt.Sprintf("ports.%d.name", i)]).To(Equal(port.Name))
+								Expect(state[fmt.Sprintf("ports.%d.link", i)]).To(Equal(port.Link))
+								Expect(state).To(HaveKey(fmt.Sprintf("ports.%d.rx_bytes", i)))
+								Expect(state).To(HaveKey(fmt.Sprintf("ports.%d.tx_bytes", i)))
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+})
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ datasource.DataSource = &upnpRedirectionsDataSource{}
+)
+
+func NewUPnPRedirectionsDataSource() datasource.DataSource {
+	return &upnpRedirectionsDataSource{}
+}
+
+type upnpRedirectionsDataSource struct {
+	client client.Client
+}
+
+type upnpRedirectionsModel struct {
+	Redirections types.List `tfsdk:"redirections"`
+}
+
+type upnpRedirectionModel struct {
+	ID          types.String `tfsdk:"id"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	IPProtocol  types.String `tfsdk:"ip_protocol"`
+	ExtPort     types.Int64  `tfsdk:"ext_port"`
+	IntPort     types.Int64  `tfsdk:"int_port"`
+	IntIP       types.String `tfsdk:"int_ip"`
+	RemoteIP    types.String `tfsdk:"remote_ip"`
+	Description types.String `tfsdk:"description"`
+	LanHost     types.Object `tfsdk:"host"`
+}
+
+func (m upnpRedirectionModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"enabled":     types.BoolType,
+		"ip_protocol": types.StringType,
+		"ext_port":    types.Int64Type,
+		"int_port":    types.Int64Type,
+		"int_ip":      types.StringType,
+		"remote_ip":   types.StringType,
+		"description": types.StringType,
+		"host":        types.ObjectType{}.WithAttributeTypes(models.LanHostModel{}.AttrTypes()),
+	}
+}
+
+func (m upnpRedirectionModel) FromClientType(redirection freeboxTypes.UPnPIGDRedirection) basetypes.ObjectValue {
+	var host basetypes.ObjectValue
+	if redirection.Host != nil {
+		host = models.LanHostModel{}.FromClientType(*redirection.Host)
+	} else {
+		host = basetypes.NewObjectNull(models.LanHostModel{}.AttrTypes())
+	}
+
+	return basetypes.NewObjectValueMust(m.AttrTypes(), map[string]attr.Value{
+		"id":          basetypes.NewStringValue(redirection.ID),
+		"enabled":     basetypes.NewBoolValue(redirection.Enabled),
+		"ip_protocol": basetypes.NewStringValue(redirection.Proto),
+		"ext_port":    basetypes.NewInt64Value(redirection.ExtPort),
+		"int_port":    basetypes.NewInt64Value(redirection.IntPort),
+		"int_ip":      basetypes.NewStringValue(redirection.IntIP),
+		"remote_ip":   basetypes.NewStringValue(redirection.RemoteIP),
+		"description": basetypes.NewStringValue(redirection.Description),
+		"host":        host,
+	})
+}
+
+func (v *upnpRedirectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_upnp_redirections"
+}
+
+func (v *upnpRedirectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the list of the port redirections currently opened through UPnP IGD by the hosts of the local network.",
+		Attributes: map[string]schema.Attribute{
+			"redirections": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of active UPnP IGD redirections, with the LAN host they point to when it is known by the Freebox",
+				ElementType: types.ObjectType{
+					AttrTypes: upnpRedirectionModel{}.AttrTypes(),
+				},
+			},
+		},
+	}
+}
+
+func (v *upnpRedirectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = client
+}
+
+func (v *upnpRedirectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model upnpRedirectionsModel
+
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	upnpRedirections, err := v.client.ListUPnPIGDRedirections(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list UPnP IGD redirections",
+			err.Error(),
+		)
+		return
+	}
+
+	redirections := make([]attr.Value, len(upnpRedirections))
+	for i, redirection := range upnpRedirections {
+		redirections[i] = upnpRedirectionModel{}.FromClientType(redirection)
+	}
+
+	var diags diag.Diagnostics
+
+	model.Redirections, diags = basetypes.NewListValueFrom(ctx, types.ObjectType{
+		AttrTypes: upnpRedirectionModel{}.AttrTypes(),
+	}, redirections)
+
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,67 @@
+package internal_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_upnp_redirections" { ... }`, func() {
+	var (
+		resName      string
+		config       string
+		redirections []freeboxTypes.UPnPIGDRedirection
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		redirections, err = freeboxClient.ListUPnPIGDRedirections(ctx)
+		Expect(err).To(BeNil())
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			data "freebox_upnp_redirections" "` + resName + `" {}
+		`
+	})
+
+	It("should list the active UPnP IGD redirections", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							attrs := s.RootModule().Resources["data.freebox_upnp_redirections."+resName].Primary.Attributes
+
+							Expect(attrs["redirections.#"]).To(Equal(strconv.Itoa(len(redirections))))
+
+							for i, redirection := range redirections {
+								Expect(attrs[fmt.Sprintf("redirections.%d.id", i)]).To(Equal(redirection.ID))
+								Expect(attrs[fmt.Sprintf("redirections.%d.ip_protocol", i)]).To(Equal(redirection.Proto))
+								Expect(attrs[fmt.Sprintf("redirections.%d.ext_port", i)]).To(Equal(strconv.FormatInt(redirection.ExtPort, 10)))
+								Expect(attrs[fmt.Sprintf("redirections.%d.int_ip", i)]).To(Equal(redirection.IntIP))
+								if redirection.Host != nil {
+									Expect(attrs[fmt.Sprintf("redirections.%d.host.id", i)]).To(Equal(redirection.Host.ID))
+								}
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+})
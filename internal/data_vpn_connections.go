@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+)
+
+var _ datasource.DataSource = &vpnConnectionsDataSource{}
+
+func NewVPNConnectionsDataSource() datasource.DataSource {
+	return &vpnConnectionsDataSource{}
+}
+
+type vpnConnectionsDataSource struct {
+	client client.Client
+}
+
+type vpnConnectionsModel struct {
+	Server      types.String `tfsdk:"server"`
+	Connections types.List   `tfsdk:"connections"`
+}
+
+type vpnConnectionModel struct {
+	ID            types.String      `tfsdk:"id"`
+	Server        types.String      `tfsdk:"server"`
+	User          types.String      `tfsdk:"user"`
+	SourceIP      types.String      `tfsdk:"source_ip"`
+	SourcePort    types.Int64       `tfsdk:"source_port"`
+	LocalIP       types.String      `tfsdk:"local_ip"`
+	Authenticated types.Bool        `tfsdk:"authenticated"`
+	AuthTime      timetypes.RFC3339 `tfsdk:"auth_time"`
+	RxBytes       types.Int64       `tfsdk:"rx_bytes"`
+	TxBytes       types.Int64       `tfsdk:"tx_bytes"`
+}
+
+func (m vpnConnectionModel) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"server":        types.StringType,
+		"user":          types.StringType,
+		"source_ip":     types.StringType,
+		"source_port":   types.Int64Type,
+		"local_ip":      types.StringType,
+		"authenticated": types.BoolType,
+		"auth_time":     timetypes.RFC3339Type{},
+		"rx_bytes":      types.Int64Type,
+		"tx_bytes":      types.Int64Type,
+	}
+}
+
+func (d *vpnConnectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_connections"
+}
+
+func (d *vpnConnectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the clients currently connected to the VPN servers of the Freebox, with their traffic counters.",
+		Attributes: map[string]schema.Attribute{
+			"server": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the connections to this VPN server (e.g. `openvpn_routed`)",
+			},
+			"connections": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of connections",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Connection identifier",
+						},
+						"server": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "VPN server the client is connected to (e.g. `openvpn_routed`)",
+						},
+						"user": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Login of the connected user",
+						},
+						"source_ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Public IP address the client connects from",
+						},
+						"source_port": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Port the client connects from",
+						},
+						"local_ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "IP address assigned to the client in the VPN subnet",
+						},
+						"authenticated": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the client is authenticated",
+						},
+						"auth_time": schema.StringAttribute{
+							Computed:            true,
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "Date of the authentication of the client",
+						},
+						"rx_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Bytes received from the client",
+						},
+						"tx_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Bytes sent to the client",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vpnConnectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = c
+}
+
+func (d *vpnConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model vpnConnectionsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connections, err := d.client.ListVPNConnections(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list VPN connections", fmt.Sprintf("Failed to list VPN connections: %s", err))
+		return
+	}
+
+	attrTypes := vpnConnectionModel{}.attrTypes()
+	items := make([]attr.Value, 0, len(connections))
+	for _, connection := range connections {
+		if !model.Server.IsNull() && connection.VPN != model.Server.ValueString() {
+			continue
+		}
+
+		items = append(items, basetypes.NewObjectValueMust(attrTypes, map[string]attr.Value{
+			"id":            types.StringValue(connection.ID),
+			"server":        types.StringValue(connection.VPN),
+			"user":          types.StringValue(connection.User),
+			"source_ip":     types.StringValue(connection.SrcIP),
+			"source_port":   types.Int64Value(connection.SrcPort),
+			"local_ip":      types.StringValue(connection.LocalIP),
+			"authenticated": types.BoolValue(connection.Authenticated),
+			"auth_time":     timetypes.NewRFC3339TimeValue(time.Unix(connection.AuthTime, 0).UTC()),
+			"rx_bytes":      types.Int64Value(connection.RxBytes),
+			"tx_bytes":      types.Int64Value(connection.TxBytes),
+		}))
+	}
+
+	var diags diag.Diagnostics
+
+	model.Connections, diags = basetypes.NewListValue(types.ObjectType{AttrTypes: attrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
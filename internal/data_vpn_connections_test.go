@@ -0,0 +1,92 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_vpn_connections" { ... }`, func() {
+	var (
+		config      string
+		resName     string
+		connections []freeboxTypes.VPNConnection
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		connections, err = freeboxClient.ListVPNConnections(ctx)
+		Expect(err).To(BeNil())
+	})
+
+	Context("without any filter", func() {
+		JustBeforeEach(func() {
+			config = providerBlock + `
+				data "freebox_vpn_connections" "` + resName + `" {
+				}
+			`
+		})
+
+		It("should list all the connections", func(ctx SpecContext) {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(
+								"data.freebox_vpn_connections."+resName,
+								"connections.#",
+								fmt.Sprintf("%d", len(connections)),
+							),
+							func(s *terraform.State) error {
+								state := s.RootModule().Resources["data.freebox_vpn_connections."+resName].Primary.Attributes
+
+								for i, connection := range connections {
+									Expect(state[fmt.Sprintf("connections.%d.id", i)]).To(Equal(connection.ID))
+									Expect(state[fmt.Sprintf("connections.%d.server", i)]).To(Equal(connection.VPN))
+									Expect(state[fmt.Sprintf("connections.%d.user", i)]).To(Equal(connection.User))
+								}
+
+								return nil
+							},
+						),
+					},
+				},
+			})
+		})
+	})
+
+	Context("with a server matching no connection", func() {
+		JustBeforeEach(func() {
+			config = providerBlock + `
+				data "freebox_vpn_connections" "` + resName + `" {
+					server = "` + resName + `"
+				}
+			`
+		})
+
+		It("should not list any connection", func(ctx SpecContext) {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.freebox_vpn_connections."+resName, "connections.#", "0"),
+						),
+					},
+				},
+			})
+		})
+	})
+})
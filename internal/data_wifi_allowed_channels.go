@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ datasource.DataSource = &wifiAllowedChannelsDataSource{}
+)
+
+func NewWifiAllowedChannelsDataSource() datasource.DataSource {
+	return &wifiAllowedChannelsDataSource{}
+}
+
+type wifiAllowedChannelsDataSource struct {
+	client client.Client
+}
+
+type wifiAllowedChannelsModel struct {
+	AccessPointID types.Int64 `tfsdk:"ap_id"`
+	Combinations  types.List  `tfsdk:"combinations"`
+}
+
+func (v *wifiAllowedChannelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi_allowed_channels"
+}
+
+func (v *wifiAllowedChannelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the channel combinations allowed on a Wi-Fi access point (radio) of the Freebox.",
+		Attributes: map[string]schema.Attribute{
+			"ap_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the Wi-Fi access point",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"combinations": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of the allowed combinations of band, channel width, primary and secondary channels",
+				ElementType: types.ObjectType{
+					AttrTypes: models.WifiChannelCombinationModel{}.AttrTypes(),
+				},
+			},
+		},
+	}
+}
+
+func (v *wifiAllowedChannelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = client
+}
+
+func (v *wifiAllowedChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model wifiAllowedChannelsModel
+
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	allowedCombinations, err := v.client.ListWifiAllowedChannelCombinations(ctx, model.AccessPointID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list allowed Wi-Fi channel combinations",
+			fmt.Sprintf("Access point: %d, Error: %s", model.AccessPointID.ValueInt64(), err),
+		)
+		return
+	}
+
+	combinations := make([]attr.Value, len(allowedCombinations))
+	for i, combination := range allowedCombinations {
+		combinations[i] = models.WifiChannelCombinationModel{}.FromClientType(combination)
+	}
+
+	var diags diag.Diagnostics
+
+	model.Combinations, diags = basetypes.NewListValueFrom(ctx, types.ObjectType{
+		AttrTypes: models.WifiChannelCombinationModel{}.AttrTypes(),
+	}, combinations)
+
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,66 @@
+package internal_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`data "freebox_wifi_allowed_channels" { ... }`, func() {
+	var (
+		resName      string
+		config       string
+		combinations []freeboxTypes.WifiChannelCombination
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		combinations, err = freeboxClient.ListWifiAllowedChannelCombinations(ctx, 0)
+		Expect(err).To(BeNil())
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			data "freebox_wifi_allowed_channels" "` + resName + `" {
+				ap_id = 0
+			}
+		`
+	})
+
+	It("should list the allowed channel combinations of the access point", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							attrs := s.RootModule().Resources["data.freebox_wifi_allowed_channels."+resName].Primary.Attributes
+
+							Expect(attrs["combinations.#"]).To(Equal(strconv.Itoa(len(combinations))))
+
+							for i, combination := range combinations {
+								Expect(attrs[fmt.Sprintf("combinations.%d.band", i)]).To(Equal(combination.Band))
+								Expect(attrs[fmt.Sprintf("combinations.%d.channel_width", i)]).To(Equal(combination.ChannelWidth))
+								Expect(attrs[fmt.Sprintf("combinations.%d.primary_channel", i)]).To(Equal(strconv.FormatInt(combination.PrimaryChannel, 10)))
+								Expect(attrs[fmt.Sprintf("combinations.%d.secondary_channels.#", i)]).To(Equal(strconv.Itoa(len(combination.SecondaryChannels))))
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	go_path "path"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+// contentLengthTimeout bounds the HEAD request used to size a download before it starts.
+const contentLengthTimeout = 10 * time.Second
+
+// checkFreeSpace fails when the partition holding the destination path has less than
+// the expected size plus the safety margin available. Unknown sizes or partitions are
+// not checked, the task will then fail on its own if the space runs out.
+func checkFreeSpace(ctx context.Context, c client.Client, destination string, expected int64, margin int64) (diagnostics diag.Diagnostics) {
+	if expected <= 0 {
+		return
+	}
+
+	partitions, err := c.ListStoragePartitions(ctx)
+	if err != nil {
+		diagnostics.AddWarning("Failed to list storage partitions", fmt.Sprintf("Skipping the free space check, Error: %s", err.Error()))
+		return
+	}
+
+	partition, ok := partitionOf(partitions, destination)
+	if !ok {
+		tflog.Debug(ctx, "No partition found for the path, skipping the free space check", map[string]interface{}{
+			"path": destination,
+		})
+		return
+	}
+
+	// Compared without summing the sizes so that a large margin can not overflow
+	if expected > partition.FreeBytes-margin {
+		diagnostics.AddError(
+			"Not enough free space",
+			fmt.Sprintf(
+				"Path: %s, Partition: %s, Required: %d bytes and a margin of %d bytes, Available: %d bytes",
+				destination, string(partition.Path), expected, margin, partition.FreeBytes,
+			),
+		)
+	}
+
+	return
+}
+
+// partitionOf returns the partition with the longest mount path containing the given path.
+func partitionOf(partitions []freeboxTypes.StoragePartition, filePath string) (partition freeboxTypes.StoragePartition, found bool) {
+	filePath = go_path.Join("/", filePath)
+
+	longest := -1
+	for _, candidate := range partitions {
+		mountPath := go_path.Join("/", string(candidate.Path))
+		if filePath != mountPath && !strings.HasPrefix(filePath, strings.TrimSuffix(mountPath, "/")+"/") {
+			continue
+		}
+		if len(mountPath) > longest {
+			partition, found, longest = candidate, true, len(mountPath)
+		}
+	}
+
+	return
+}
+
+// contentLength returns the size announced by the server for the given URL, or -1 when it is unknown.
+func contentLength(ctx context.Context, url string, username string, password string) int64 {
+	ctx, cancel := context.WithTimeout(ctx, contentLengthTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return -1
+	}
+
+	if username != "" || password != "" {
+		request.SetBasicAuth(username, password)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to get the size of the download", map[string]interface{}{
+			"url":   url,
+			"error": err.Error(),
+		})
+		return -1
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return -1
+	}
+
+	return response.ContentLength
+}
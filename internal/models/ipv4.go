@@ -0,0 +1,15 @@
+package models
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var ipv4Regex = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+\.[0-9]+$`)
+
+// IPv4Validator validates that a string is an IPv4 address in dotted decimal notation.
+func IPv4Validator() validator.String {
+	return stringvalidator.RegexMatches(ipv4Regex, "Must be a valid IPv4 address")
+}
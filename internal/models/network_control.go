@@ -0,0 +1,136 @@
+package models
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	NetworkControlModeAllowed = "allowed"
+	NetworkControlModeDenied  = "denied"
+)
+
+type NetworkControlScheduleRangeModel struct {
+	Days  types.List   `tfsdk:"days"`
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+	Mode  types.String `tfsdk:"mode"`
+}
+
+func (o NetworkControlScheduleRangeModel) ResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"days": schema.ListAttribute{
+			Required:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Days of the week the time range applies to (`monday`, `tuesday`, ..., `sunday`)",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(WeekDays...)),
+			},
+		},
+		"start": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Start of the time range, inclusive, in the `HH:MM` format",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(timeOfDayRegex, "Must be a time of the day in the HH:MM format"),
+			},
+		},
+		"end": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "End of the time range, exclusive, in the `HH:MM` format. Use `24:00` for the end of the day",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(timeOfDayRegex, "Must be a time of the day in the HH:MM format"),
+			},
+		},
+		"mode": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Access mode during the time range (`allowed` or `denied`)",
+			Validators: []validator.String{
+				stringvalidator.OneOf(NetworkControlModeAllowed, NetworkControlModeDenied),
+			},
+		},
+	}
+}
+
+func (o NetworkControlScheduleRangeModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"days":  types.ListType{}.WithElementType(types.StringType),
+		"start": types.StringType,
+		"end":   types.StringType,
+		"mode":  types.StringType,
+	}
+}
+
+// NetworkControlScheduleToMapping converts a list of schedule ranges into the slot mapping
+// of the network control planning. Slots not covered by any range are set to defaultMode.
+func NetworkControlScheduleToMapping(ctx context.Context, schedule basetypes.ListValue, defaultMode string, resolution int) (mapping []string, diagnostics diag.Diagnostics) {
+	var rangeModels []NetworkControlScheduleRangeModel
+	if !schedule.IsNull() && !schedule.IsUnknown() {
+		if diags := schedule.ElementsAs(ctx, &rangeModels, false); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	ranges := make([]scheduleRange, len(rangeModels))
+	for i, model := range rangeModels {
+		ranges[i] = scheduleRange{
+			start: model.Start.ValueString(),
+			end:   model.End.ValueString(),
+			mode:  model.Mode.ValueString(),
+		}
+		if diags := model.Days.ElementsAs(ctx, &ranges[i].days, false); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	mapping, err := scheduleToMapping(ranges, defaultMode, resolution)
+	if err != nil {
+		diagnostics.AddError("Invalid network control schedule", err.Error())
+		return
+	}
+
+	return mapping, nil
+}
+
+// NetworkControlScheduleFromMapping converts the slot mapping of the network control planning
+// back into a list of schedule ranges, ignoring the slots set to defaultMode.
+func NetworkControlScheduleFromMapping(mapping []string, defaultMode string, resolution int) (schedule basetypes.ListValue, diagnostics diag.Diagnostics) {
+	elementType := types.ObjectType{}.WithAttributeTypes(NetworkControlScheduleRangeModel{}.AttrTypes())
+
+	ranges, err := scheduleFromMapping(mapping, defaultMode, resolution)
+	if err != nil {
+		diagnostics.AddError("Unsupported network control planning", err.Error())
+		return
+	}
+
+	if len(ranges) == 0 {
+		return basetypes.NewListNull(elementType), nil
+	}
+
+	elements := make([]attr.Value, len(ranges))
+	for i, r := range ranges {
+		days := make([]attr.Value, len(r.days))
+		for j, day := range r.days {
+			days[j] = basetypes.NewStringValue(day)
+		}
+		elements[i] = basetypes.NewObjectValueMust(NetworkControlScheduleRangeModel{}.AttrTypes(), map[string]attr.Value{
+			"days":  basetypes.NewListValueMust(types.StringType, days),
+			"start": basetypes.NewStringValue(r.start),
+			"end":   basetypes.NewStringValue(r.end),
+			"mode":  basetypes.NewStringValue(r.mode),
+		})
+	}
+
+	return basetypes.NewListValueMust(elementType, elements), nil
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// WeekDays lists the days of the week in the order of the slots of the Freebox plannings.
+var WeekDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+const minutesPerDay = 24 * 60
+
+var timeOfDayRegex = regexp.MustCompile(`^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$`)
+
+// scheduleRange is a time range of a weekly planning, applying mode to the given days.
+type scheduleRange struct {
+	days       []string
+	start, end string
+	mode       string
+}
+
+// scheduleToMapping converts a list of ranges into the slot mapping of a Freebox planning:
+// one mode per slot, for each day of the week starting on Monday, each day being split into
+// resolution slots. Slots not covered by any range are set to defaultMode.
+func scheduleToMapping(ranges []scheduleRange, defaultMode string, resolution int) ([]string, error) {
+	if resolution <= 0 || minutesPerDay%resolution != 0 {
+		return nil, fmt.Errorf("the Freebox uses %d slots per day which is not supported", resolution)
+	}
+
+	slotDuration := minutesPerDay / resolution
+
+	mapping := make([]string, len(WeekDays)*resolution)
+	for i := range mapping {
+		mapping[i] = defaultMode
+	}
+
+	covered := make([]bool, len(mapping))
+	for i, r := range ranges {
+		start, err := parseTimeOfDay(r.start)
+		if err != nil {
+			return nil, fmt.Errorf("range %d: start: %w", i, err)
+		}
+		end, err := parseTimeOfDay(r.end)
+		if err != nil {
+			return nil, fmt.Errorf("range %d: end: %w", i, err)
+		}
+		if start >= end {
+			return nil, fmt.Errorf("range %d: start %s must be before end %s", i, r.start, r.end)
+		}
+		if start%slotDuration != 0 || end%slotDuration != 0 {
+			return nil, fmt.Errorf("range %d: %s-%s must be aligned on the %d minutes slots of the Freebox planning", i, r.start, r.end, slotDuration)
+		}
+
+		for _, day := range r.days {
+			dayIndex := slices.Index(WeekDays, day)
+			if dayIndex < 0 {
+				return nil, fmt.Errorf("range %d: unknown day %q", i, day)
+			}
+			for slot := start / slotDuration; slot < end/slotDuration; slot++ {
+				index := dayIndex*resolution + slot
+				if covered[index] && mapping[index] != r.mode {
+					return nil, fmt.Errorf("range %d: %s %s overlaps with another range using a different mode", i, day, formatTimeOfDay(slot*slotDuration))
+				}
+				covered[index] = true
+				mapping[index] = r.mode
+			}
+		}
+	}
+
+	return mapping, nil
+}
+
+// scheduleFromMapping converts the slot mapping of a Freebox planning back into a list of
+// ranges. Consecutive slots of a day that are not set to defaultMode are grouped into ranges,
+// and identical ranges of different days are merged.
+func scheduleFromMapping(mapping []string, defaultMode string, resolution int) ([]scheduleRange, error) {
+	if resolution <= 0 || minutesPerDay%resolution != 0 || len(mapping) != len(WeekDays)*resolution {
+		return nil, fmt.Errorf("expected %d slots per day over %d days but got %d slots", resolution, len(WeekDays), len(mapping))
+	}
+
+	slotDuration := minutesPerDay / resolution
+
+	ranges := make([]*scheduleRange, 0)
+	for dayIndex, day := range WeekDays {
+		slots := mapping[dayIndex*resolution : (dayIndex+1)*resolution]
+		for slot := 0; slot < resolution; {
+			mode := slots[slot]
+			if mode == defaultMode {
+				slot++
+				continue
+			}
+
+			end := slot
+			for end < resolution && slots[end] == mode {
+				end++
+			}
+
+			start, stop := formatTimeOfDay(slot*slotDuration), formatTimeOfDay(end*slotDuration)
+			index := slices.IndexFunc(ranges, func(r *scheduleRange) bool {
+				return r.start == start && r.end == stop && r.mode == mode
+			})
+			if index < 0 {
+				ranges = append(ranges, &scheduleRange{start: start, end: stop, mode: mode})
+				index = len(ranges) - 1
+			}
+			ranges[index].days = append(ranges[index].days, day)
+
+			slot = end
+		}
+	}
+
+	result := make([]scheduleRange, len(ranges))
+	for i, r := range ranges {
+		result[i] = *r
+	}
+
+	return result, nil
+}
+
+// parseTimeOfDay parses a HH:MM time of the day into a number of minutes since midnight.
+func parseTimeOfDay(value string) (int, error) {
+	if !timeOfDayRegex.MatchString(value) {
+		return 0, fmt.Errorf("%q is not a time of the day in the HH:MM format", value)
+	}
+
+	hours, _ := strconv.Atoi(value[:2])
+	minutes, _ := strconv.Atoi(value[3:])
+
+	return hours*60 + minutes, nil
+}
+
+// formatTimeOfDay formats a number of minutes since midnight into a HH:MM time of the day.
+func formatTimeOfDay(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,119 @@
+package models
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("schedule", func() {
+	const resolution = 48 // 30 minutes slots
+
+	// slotsOf returns the indexes of the slots of the mapping set to mode.
+	slotsOf := func(mapping []string, mode string) []int {
+		slots := make([]int, 0)
+		for i, value := range mapping {
+			if value == mode {
+				slots = append(slots, i)
+			}
+		}
+		return slots
+	}
+
+	Context("scheduleToMapping", func() {
+		It("should set the slots not covered by any range to the default mode", func() {
+			mapping, err := scheduleToMapping(nil, "allowed", resolution)
+			Expect(err).To(BeNil())
+			Expect(mapping).To(HaveLen(7 * resolution))
+			Expect(slotsOf(mapping, "allowed")).To(HaveLen(7 * resolution))
+		})
+
+		It("should set the slots of each day of a range", func() {
+			mapping, err := scheduleToMapping([]scheduleRange{
+				{days: []string{"monday", "sunday"}, start: "21:30", end: "24:00", mode: "denied"},
+			}, "allowed", resolution)
+			Expect(err).To(BeNil())
+			Expect(slotsOf(mapping, "denied")).To(Equal([]int{
+				43, 44, 45, 46, 47, // Monday 21:30 to 24:00
+				6*resolution + 43, 6*resolution + 44, 6*resolution + 45, 6*resolution + 46, 6*resolution + 47, // Sunday 21:30 to 24:00
+			}))
+		})
+
+		It("should accept overlapping ranges using the same mode", func() {
+			mapping, err := scheduleToMapping([]scheduleRange{
+				{days: []string{"tuesday"}, start: "08:00", end: "10:00", mode: "denied"},
+				{days: []string{"tuesday"}, start: "09:00", end: "11:00", mode: "denied"},
+			}, "allowed", resolution)
+			Expect(err).To(BeNil())
+			Expect(slotsOf(mapping, "denied")).To(Equal([]int{
+				resolution + 16, resolution + 17, resolution + 18, resolution + 19, resolution + 20, resolution + 21,
+			}))
+		})
+
+		DescribeTable("should reject",
+			func(r scheduleRange, res int, message string) {
+				_, err := scheduleToMapping([]scheduleRange{r}, "allowed", res)
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("an unsupported resolution", scheduleRange{days: []string{"monday"}, start: "08:00", end: "09:00", mode: "denied"}, 7, "7 slots per day"),
+			Entry("a malformed time", scheduleRange{days: []string{"monday"}, start: "8:00", end: "09:00", mode: "denied"}, resolution, "HH:MM"),
+			Entry("an empty range", scheduleRange{days: []string{"monday"}, start: "09:00", end: "09:00", mode: "denied"}, resolution, "must be before"),
+			Entry("a range not aligned on the slots", scheduleRange{days: []string{"monday"}, start: "08:15", end: "09:00", mode: "denied"}, resolution, "aligned on the 30 minutes slots"),
+			Entry("an unknown day", scheduleRange{days: []string{"someday"}, start: "08:00", end: "09:00", mode: "denied"}, resolution, `unknown day "someday"`),
+		)
+
+		It("should reject overlapping ranges using different modes", func() {
+			_, err := scheduleToMapping([]scheduleRange{
+				{days: []string{"friday"}, start: "08:00", end: "10:00", mode: "denied"},
+				{days: []string{"friday"}, start: "09:30", end: "11:00", mode: "allowed"},
+			}, "allowed", resolution)
+			Expect(err).To(MatchError(ContainSubstring("friday 09:30 overlaps")))
+		})
+	})
+
+	Context("scheduleFromMapping", func() {
+		It("should return no range when every slot uses the default mode", func() {
+			mapping, err := scheduleToMapping(nil, "denied", resolution)
+			Expect(err).To(BeNil())
+
+			ranges, err := scheduleFromMapping(mapping, "denied", resolution)
+			Expect(err).To(BeNil())
+			Expect(ranges).To(BeEmpty())
+		})
+
+		It("should merge the identical ranges of different days", func() {
+			mapping, err := scheduleToMapping([]scheduleRange{
+				{days: []string{"saturday", "monday"}, start: "00:00", end: "07:00", mode: "denied"},
+				{days: []string{"monday"}, start: "21:30", end: "24:00", mode: "denied"},
+			}, "allowed", resolution)
+			Expect(err).To(BeNil())
+
+			ranges, err := scheduleFromMapping(mapping, "allowed", resolution)
+			Expect(err).To(BeNil())
+			Expect(ranges).To(Equal([]scheduleRange{
+				{days: []string{"monday", "saturday"}, start: "00:00", end: "07:00", mode: "denied"},
+				{days: []string{"monday"}, start: "21:30", end: "24:00", mode: "denied"},
+			}))
+		})
+
+		It("should split a day into ranges of consecutive slots using the same mode", func() {
+			mapping, err := scheduleToMapping(nil, "denied", resolution)
+			Expect(err).To(BeNil())
+			for slot := 16; slot < 20; slot++ {
+				mapping[2*resolution+slot] = "allowed" // Wednesday 08:00 to 10:00
+			}
+			mapping[2*resolution+47] = "allowed" // Wednesday 23:30 to 24:00
+
+			ranges, err := scheduleFromMapping(mapping, "denied", resolution)
+			Expect(err).To(BeNil())
+			Expect(ranges).To(Equal([]scheduleRange{
+				{days: []string{"wednesday"}, start: "08:00", end: "10:00", mode: "allowed"},
+				{days: []string{"wednesday"}, start: "23:30", end: "24:00", mode: "allowed"},
+			}))
+		})
+
+		It("should reject a mapping not matching the resolution", func() {
+			_, err := scheduleFromMapping(make([]string, 7*resolution-1), "allowed", resolution)
+			Expect(err).To(MatchError(ContainSubstring("expected 48 slots per day over 7 days")))
+		})
+	})
+})
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	wifiPlanningOn  = "on"
+	wifiPlanningOff = "off"
+)
+
+// WifiPlanningRangeModel is a time range during which the Wi-Fi is turned off.
+type WifiPlanningRangeModel struct {
+	Days  types.List   `tfsdk:"days"`
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+func (o WifiPlanningRangeModel) ResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"days": schema.ListAttribute{
+			Required:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Days of the week the time range applies to (`monday`, `tuesday`, ..., `sunday`)",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(WeekDays...)),
+			},
+		},
+		"start": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Start of the time range, inclusive, in the `HH:MM` format",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(timeOfDayRegex, "Must be a time of the day in the HH:MM format"),
+			},
+		},
+		"end": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "End of the time range, exclusive, in the `HH:MM` format. Use `24:00` for the end of the day",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(timeOfDayRegex, "Must be a time of the day in the HH:MM format"),
+			},
+		},
+	}
+}
+
+func (o WifiPlanningRangeModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"days":  types.ListType{}.WithElementType(types.StringType),
+		"start": types.StringType,
+		"end":   types.StringType,
+	}
+}
+
+// WifiPlanningToMapping converts a list of time ranges during which the Wi-Fi is turned off
+// into the slot mapping of the Wi-Fi planning.
+func WifiPlanningToMapping(ctx context.Context, offRanges basetypes.ListValue, resolution int) (mapping []string, diagnostics diag.Diagnostics) {
+	var rangeModels []WifiPlanningRangeModel
+	if !offRanges.IsNull() && !offRanges.IsUnknown() {
+		if diags := offRanges.ElementsAs(ctx, &rangeModels, false); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	ranges := make([]scheduleRange, len(rangeModels))
+	for i, model := range rangeModels {
+		ranges[i] = scheduleRange{
+			start: model.Start.ValueString(),
+			end:   model.End.ValueString(),
+			mode:  wifiPlanningOff,
+		}
+		if diags := model.Days.ElementsAs(ctx, &ranges[i].days, false); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	mapping, err := scheduleToMapping(ranges, wifiPlanningOn, resolution)
+	if err != nil {
+		diagnostics.AddError("Invalid Wi-Fi planning", err.Error())
+		return
+	}
+
+	return mapping, nil
+}
+
+// WifiPlanningFromMapping converts the slot mapping of the Wi-Fi planning back into the list
+// of time ranges during which the Wi-Fi is turned off.
+func WifiPlanningFromMapping(mapping []string, resolution int) (offRanges basetypes.ListValue, diagnostics diag.Diagnostics) {
+	elementType := types.ObjectType{}.WithAttributeTypes(WifiPlanningRangeModel{}.AttrTypes())
+
+	ranges, err := scheduleFromMapping(mapping, wifiPlanningOn, resolution)
+	if err != nil {
+		diagnostics.AddError("Unsupported Wi-Fi planning", err.Error())
+		return
+	}
+
+	if len(ranges) == 0 {
+		return basetypes.NewListNull(elementType), nil
+	}
+
+	elements := make([]attr.Value, len(ranges))
+	for i, r := range ranges {
+		days := make([]attr.Value, len(r.days))
+		for j, day := range r.days {
+			days[j] = basetypes.NewStringValue(day)
+		}
+		elements[i] = basetypes.NewObjectValueMust(WifiPlanningRangeModel{}.AttrTypes(), map[string]attr.Value{
+			"days":  basetypes.NewListValueMust(types.StringType, days),
+			"start": basetypes.NewStringValue(r.start),
+			"end":   basetypes.NewStringValue(r.end),
+		})
+	}
+
+	return basetypes.NewListValueMust(elementType, elements), nil
+}
+
+// WifiChannelCombinationModel describes a channel combination allowed on a Wi-Fi access point.
+type WifiChannelCombinationModel struct {
+	Band              types.String `tfsdk:"band"`
+	ChannelWidth      types.String `tfsdk:"channel_width"`
+	PrimaryChannel    types.Int64  `tfsdk:"primary_channel"`
+	SecondaryChannels types.List   `tfsdk:"secondary_channels"`
+	NeedDFS           types.Bool   `tfsdk:"need_dfs"`
+}
+
+func (o WifiChannelCombinationModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"band":               types.StringType,
+		"channel_width":      types.StringType,
+		"primary_channel":    types.Int64Type,
+		"secondary_channels": types.ListType{}.WithElementType(types.Int64Type),
+		"need_dfs":           types.BoolType,
+	}
+}
+
+func (o WifiChannelCombinationModel) FromClientType(combination freeboxTypes.WifiChannelCombination) basetypes.ObjectValue {
+	secondaryChannels := make([]attr.Value, len(combination.SecondaryChannels))
+	for i, channel := range combination.SecondaryChannels {
+		secondaryChannels[i] = basetypes.NewInt64Value(channel)
+	}
+
+	return basetypes.NewObjectValueMust(o.AttrTypes(), map[string]attr.Value{
+		"band":               basetypes.NewStringValue(combination.Band),
+		"channel_width":      basetypes.NewStringValue(combination.ChannelWidth),
+		"primary_channel":    basetypes.NewInt64Value(combination.PrimaryChannel),
+		"secondary_channels": basetypes.NewListValueMust(types.Int64Type, secondaryChannels),
+		"need_dfs":           basetypes.NewBoolValue(combination.NeedDFS),
+	})
+}
@@ -128,6 +128,26 @@ func (p *freeboxProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewVPNServerResource,
 		NewVPNUserResource,
 		NewLanConfigResource,
+		NewDMZResource,
+		NewIncomingPortResource,
+		NewUPnPIGDConfigResource,
+		NewLanHostResource,
+		NewWakeOnLanResource,
+		NewNetworkControlProfileResource,
+		NewWifiConfigResource,
+		NewWifiAccessPointResource,
+		NewWifiBSSResource,
+		NewWifiGuestKeyResource,
+		NewWifiMacFilterResource,
+		NewDynDNSResource,
+		NewDNSConfigResource,
+		NewSwitchPortResource,
+		NewSambaConfigResource,
+		NewAFPConfigResource,
+		NewFTPConfigResource,
+		NewShareLinkResource,
+		NewDirectoryResource,
+		NewArchiveResource,
 	}
 }
 
@@ -143,6 +163,17 @@ func (p *freeboxProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewVMDistributionsDataSource,
 		NewLanInterfacesDataSource,
 		NewSystemInfoDataSource,
+		NewUPnPRedirectionsDataSource,
+		NewWifiAllowedChannelsDataSource,
+		NewConnectionDataSource,
+		NewConnectionFTTHDataSource,
+		NewConnectionXDSLDataSource,
+		NewSwitchPortsDataSource,
+		NewRRDDataSource,
+		NewStorageDisksDataSource,
+		NewStoragePartitionsDataSource,
+		NewFilesDataSource,
+		NewVPNConnectionsDataSource,
 	}
 }
 
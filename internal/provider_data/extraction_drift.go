@@ -0,0 +1,27 @@
+package providerdata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const extractionDriftKey = "extraction_drift"
+
+// SetExtractionDrift records whether some of the extracted files went missing since the extraction.
+func SetExtractionDrift(ctx context.Context, state Setter, drift bool) (diagnotics diag.Diagnostics) {
+	if !drift {
+		return state.SetKey(ctx, extractionDriftKey, nil)
+	}
+
+	return state.SetKey(ctx, extractionDriftKey, []byte("true"))
+}
+
+func GetExtractionDrift(ctx context.Context, state Getter) (drift bool, diagnotics diag.Diagnostics) {
+	driftBytes, diags := state.GetKey(ctx, extractionDriftKey)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return string(driftBytes) == "true", nil
+}
@@ -0,0 +1,247 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &afpConfigResource{}
+)
+
+// afpServerTypes lists the icons the Freebox can be advertised with to the Finder.
+var afpServerTypes = []string{
+	"powerbook",
+	"powermac",
+	"macmini",
+	"imac",
+	"macbook",
+	"macbookpro",
+	"macbookair",
+	"macpro",
+	"appletv",
+	"airport",
+	"xserve",
+}
+
+func NewAFPConfigResource() resource.Resource {
+	return &afpConfigResource{}
+}
+
+type afpConfigResource struct {
+	client client.Client
+}
+
+type afpConfigModel struct {
+	ID            types.String `tfsdk:"id"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	GuestAllowed  types.Bool   `tfsdk:"guest_allowed"`
+	ServerType    types.String `tfsdk:"server_type"`
+	LoginName     types.String `tfsdk:"login_name"`
+	LoginPassword types.String `tfsdk:"login_password"`
+}
+
+// toPayload applies the values of the model on top of the current AFP configuration.
+func (m *afpConfigModel) toPayload(current freeboxTypes.AFPConfig) freeboxTypes.AFPConfig {
+	payload := current
+	payload.Enabled = m.Enabled.ValueBool()
+	payload.GuestAllow = m.GuestAllowed.ValueBool()
+	payload.ServerType = m.ServerType.ValueString()
+	payload.LoginName = m.LoginName.ValueString()
+
+	// The password is never returned by the Freebox, the current one is kept when unset
+	if !m.LoginPassword.IsNull() && !m.LoginPassword.IsUnknown() {
+		payload.LoginPassword = m.LoginPassword.ValueString()
+	}
+
+	return payload
+}
+
+// fromClientType sets the model from the AFP configuration. The login password is never
+// returned by the Freebox, so the one of the model is kept.
+func (m *afpConfigModel) fromClientType(config freeboxTypes.AFPConfig) {
+	m.ID = basetypes.NewStringValue("afp")
+	m.Enabled = basetypes.NewBoolValue(config.Enabled)
+	m.GuestAllowed = basetypes.NewBoolValue(config.GuestAllow)
+	m.ServerType = basetypes.NewStringValue(config.ServerType)
+	if config.LoginName != "" || !m.LoginName.IsNull() {
+		m.LoginName = basetypes.NewStringValue(config.LoginName)
+	}
+}
+
+func (v *afpConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_afp_config"
+}
+
+func (v *afpConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the AFP (Apple Filing Protocol) file sharing of the Freebox. This is a singleton resource: only one AFP configuration exists per Freebox. Destroying this resource disables the AFP file sharing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier for the singleton AFP configuration resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the AFP file sharing is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"guest_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the shares can be accessed without authentication",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"server_type": schema.StringAttribute{
+				MarkdownDescription: "Icon the Freebox is shown with in the Finder (e.g. `powerbook`, `macmini`, `imac`, `xserve`)",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("powerbook"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(afpServerTypes...),
+				},
+			},
+			"login_name": schema.StringAttribute{
+				MarkdownDescription: "User name to access the shares",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("login_password")),
+				},
+			},
+			"login_password": schema.StringAttribute{
+				MarkdownDescription: "Password to access the shares, the current one is kept if not set. It is hidden from the plan output but stored in the Terraform state",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("login_name")),
+				},
+			},
+		},
+	}
+}
+
+func (v *afpConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *afpConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model afpConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateSingletonConfig(ctx, v.client.GetAFPConfig, v.client.UpdateAFPConfig, model.toPayload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure AFP",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *afpConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model afpConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.GetAFPConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read AFP config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *afpConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model afpConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateSingletonConfig(ctx, v.client.GetAFPConfig, v.client.UpdateAFPConfig, model.toPayload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update AFP config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *afpConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model afpConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := updateSingletonConfig(ctx, v.client.GetAFPConfig, v.client.UpdateAFPConfig, func(current freeboxTypes.AFPConfig) freeboxTypes.AFPConfig {
+		current.Enabled = false
+		return current
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable AFP",
+			err.Error(),
+		)
+	}
+}
+
+func (v *afpConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "afp")...)
+}
@@ -0,0 +1,96 @@
+package internal_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_afp_config" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		originalConfig freeboxTypes.AFPConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		originalConfig, err = freeboxClient.GetAFPConfig(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateAFPConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original AFP config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_afp_config" "` + resName + `" {
+				server_type    = "macmini"
+				login_name     = "` + resName + `"
+				login_password = "` + resName + `"
+			}
+		`
+	})
+
+	It("should configure, update, import and disable the AFP file sharing", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_afp_config."+resName, "id", "afp"),
+						resource.TestCheckResourceAttr("freebox_afp_config."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_afp_config."+resName, "guest_allowed", "false"),
+						resource.TestCheckResourceAttr("freebox_afp_config."+resName, "server_type", "macmini"),
+						resource.TestCheckResourceAttr("freebox_afp_config."+resName, "login_name", resName),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetAFPConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.Enabled).To(BeTrue())
+							Expect(config.ServerType).To(Equal("macmini"))
+							Expect(config.LoginName).To(Equal(resName))
+							return nil
+						},
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("server_type", "xserve")(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_afp_config."+resName, "server_type", "xserve"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetAFPConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.ServerType).To(Equal("xserve"))
+							return nil
+						},
+					),
+				},
+				{
+					Config:                  terraformConfigWithAttribute("server_type", "xserve")(config),
+					ResourceName:            "freebox_afp_config." + resName,
+					ImportState:             true,
+					ImportStateId:           "afp",
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"login_password"},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetAFPConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(config.Enabled).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
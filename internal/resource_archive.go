@@ -0,0 +1,497 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+	providerdata "github.com/nikolalohinski/terraform-provider-freebox/internal/provider_data"
+)
+
+// archiveExtensions are the archive formats the Freebox can create, picked from the destination extension.
+var archiveExtensions = regexp.MustCompile(`\.(zip|tar|tar\.gz|tgz|tar\.bz2|tar\.xz|7z)$`)
+
+var (
+	_ resource.Resource               = (*archiveResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*archiveResource)(nil)
+)
+
+func NewArchiveResource() resource.Resource {
+	return &archiveResource{}
+}
+
+// archiveResource defines the resource implementation.
+type archiveResource struct {
+	client client.Client
+}
+
+// archiveModel describes the resource data model.
+type archiveModel struct {
+	// DestinationPath is the path to the archive on the Freebox.
+	DestinationPath types.String `tfsdk:"destination_path"`
+	// SourcePaths are the paths of the files and directories to archive.
+	SourcePaths types.List `tfsdk:"source_paths"`
+	// SourceChecksums are the checksums of the source files when the archive was created.
+	SourceChecksums types.Map `tfsdk:"source_checksums"`
+	// Size is the size of the archive in bytes.
+	Size types.Int64 `tfsdk:"size"`
+
+	// Polling is the polling configuration.
+	Polling types.Object `tfsdk:"polling"`
+}
+
+type archivePollingModel struct {
+	// Create is the polling configuration for the archive operation.
+	Create types.Object `tfsdk:"create"`
+	// ChecksumCompute is the polling configuration for the checksum compute operation.
+	ChecksumCompute types.Object `tfsdk:"checksum_compute"`
+	// Delete is the polling configuration for the delete operation.
+	Delete types.Object `tfsdk:"delete"`
+}
+
+func (o archivePollingModel) defaults() basetypes.ObjectValue {
+	return basetypes.NewObjectValueMust(archivePollingModel{}.AttrTypes(), map[string]attr.Value{
+		"create":           models.NewPollingSpecModel(time.Second, 10*time.Minute),
+		"checksum_compute": models.NewPollingSpecModel(time.Second, 2*time.Minute),
+		"delete":           models.NewPollingSpecModel(time.Second, time.Minute),
+	})
+}
+
+func (o archivePollingModel) ResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"create": schema.SingleNestedAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Archive creation polling configuration",
+			Attributes:          models.PollingSpecModelResourceAttributes(time.Second, 10*time.Minute),
+			Default:             objectdefault.StaticValue(models.NewPollingSpecModel(time.Second, 10*time.Minute)),
+		},
+		"checksum_compute": schema.SingleNestedAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Checksum compute polling configuration",
+			Attributes:          models.PollingSpecModelResourceAttributes(time.Second, 2*time.Minute),
+			Default:             objectdefault.StaticValue(models.NewPollingSpecModel(time.Second, 2*time.Minute)),
+		},
+		"delete": schema.SingleNestedAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Deletion polling configuration",
+			Attributes:          models.PollingSpecModelResourceAttributes(time.Second, time.Minute),
+			Default:             objectdefault.StaticValue(models.NewPollingSpecModel(time.Second, time.Minute)),
+		},
+	}
+}
+
+func (o archivePollingModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"create":           types.ObjectType{}.WithAttributeTypes(models.Polling{}.AttrTypes()),
+		"checksum_compute": types.ObjectType{}.WithAttributeTypes(models.Polling{}.AttrTypes()),
+		"delete":           types.ObjectType{}.WithAttributeTypes(models.Polling{}.AttrTypes()),
+	}
+}
+
+func (v *archiveModel) populateDefaults() {
+	if v.Polling.IsUnknown() || v.Polling.IsNull() {
+		v.Polling = archivePollingModel{}.defaults()
+	}
+}
+
+func (v *archiveModel) polling(ctx context.Context) (polling archivePollingModel, diagnostics diag.Diagnostics) {
+	diagnostics.Append(v.Polling.As(ctx, &polling, basetypes.ObjectAsOptions{})...)
+	return
+}
+
+func (v *archiveModel) sourcePaths(ctx context.Context) (sources []string, diagnostics diag.Diagnostics) {
+	diagnostics.Append(v.SourcePaths.ElementsAs(ctx, &sources, false)...)
+	return
+}
+
+func (v *archiveResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_archive"
+}
+
+func (v *archiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Builds an archive on the Freebox from a set of files and directories. The archive is re-created when the checksum of a source file changes.",
+		Attributes: map[string]schema.Attribute{
+			"destination_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the archive on the Freebox. The extension selects the format: `.zip`, `.tar`, `.tar.gz`, `.tgz`, `.tar.bz2`, `.tar.xz` or `.7z`",
+				Required:            true,
+				Validators: []validator.String{
+					models.FilePathValidator(path.Root("destination_path")),
+					stringvalidator.RegexMatches(archiveExtensions, "must end with a supported archive extension"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_paths": schema.ListAttribute{
+				MarkdownDescription: "Paths of the files and directories to put in the archive",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_checksums": schema.MapAttribute{
+				MarkdownDescription: "Checksums of the source files when the archive was created, by path. The content of the source directories is not tracked",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the archive in bytes",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"polling": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Polling configuration",
+				Attributes:          archivePollingModel{}.ResourceAttributes(),
+				Default:             objectdefault.StaticValue(archivePollingModel{}.defaults()),
+			},
+		},
+	}
+}
+
+func (v *archiveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = client
+}
+
+func (v *archiveResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || v.client == nil {
+		return // Only existing archives can be outdated, when the provider is configured
+	}
+
+	var plan, state archiveModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SourcePaths.Equal(state.SourcePaths) || !plan.DestinationPath.Equal(state.DestinationPath) {
+		return // Replaced anyway
+	}
+
+	if task, diags := providerdata.GetCurrentTask(ctx, req.Private); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	} else if task != nil {
+		return // The pending task must stay tracked until it is cleaned up when applying
+	}
+
+	plan.populateDefaults()
+
+	polling, diags := plan.polling(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	checksums, diags := v.sourceChecksums(ctx, resp.Private, &plan, polling)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if checksums.Equal(state.SourceChecksums) {
+		return
+	}
+
+	tflog.Info(ctx, "The source files changed, the archive will be re-created", map[string]interface{}{
+		"path": plan.DestinationPath.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_checksums"), basetypes.NewMapUnknown(types.StringType))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("size"), basetypes.NewInt64Unknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_checksums"))
+}
+
+func (v *archiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model archiveModel
+
+	if diags := req.Plan.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	model.populateDefaults()
+
+	destination := model.DestinationPath.ValueString()
+
+	if _, err := v.client.GetFileInfo(ctx, destination); err == nil {
+		resp.Diagnostics.AddError("File already exists", fmt.Sprintf("Please delete the file %q first", destination))
+		return
+	} else if !errors.Is(err, client.ErrPathNotFound) {
+		resp.Diagnostics.AddError("Failed to check file existence", fmt.Sprintf("Path: %s, Error: %s", destination, err.Error()))
+		return
+	}
+
+	model.Size = basetypes.NewInt64Null()
+	model.SourceChecksums = basetypes.NewMapNull(types.StringType)
+
+	// Saved even when failing, so that the archive and its pending task are cleaned up when the tainted resource is replaced
+	defer func() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	}()
+
+	sources, diags := model.sourcePaths(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	polling, diags := model.polling(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var createPolling models.Polling
+
+	if diags := polling.Create.As(ctx, &createPolling, basetypes.ObjectAsOptions{}); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	files := make([]freeboxTypes.Base64Path, 0, len(sources))
+	for _, source := range sources {
+		files = append(files, freeboxTypes.Base64Path(source))
+	}
+
+	tflog.Info(ctx, "Creating the archive...", map[string]interface{}{
+		"path":    destination,
+		"sources": sources,
+	})
+
+	task, err := v.client.CreateArchive(ctx, freeboxTypes.ArchivePayload{
+		Files: files,
+		Dst:   freeboxTypes.Base64Path(destination),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create archive", fmt.Sprintf("Path: %s, Error: %s", destination, err.Error()))
+		return
+	}
+
+	if diags := providerdata.SetCurrentTask(ctx, resp.Private, models.TaskTypeFileSystem, task.ID); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if diags := waitForFileSystemTask(ctx, v.client, task.ID, createPolling); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if err := stopAndDeleteFileSystemTask(ctx, v.client, task.ID); err != nil {
+		resp.Diagnostics.AddError("Failed to stop and delete file system task", fmt.Sprintf("Task %d, Error: %s", task.ID, err.Error()))
+		return
+	}
+
+	if diags := providerdata.UnsetCurrentTask(ctx, resp.Private); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	fileInfo, err := v.client.GetFileInfo(ctx, destination)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get archive", fmt.Sprintf("Path: %s, Error: %s", destination, err.Error()))
+		return
+	}
+
+	model.Size = basetypes.NewInt64Value(int64(fileInfo.SizeBytes))
+
+	model.SourceChecksums, diags = v.sourceChecksums(ctx, resp.Private, &model, polling)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+func (v *archiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model archiveModel
+
+	if diags := req.State.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	fileInfo, err := v.client.GetFileInfo(ctx, model.DestinationPath.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrPathNotFound) {
+			tflog.Info(ctx, "Archive not found", map[string]interface{}{
+				"path": model.DestinationPath.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to get archive", fmt.Sprintf("Path: %s, Error: %s", model.DestinationPath.ValueString(), err.Error()))
+		return
+	}
+
+	model.populateDefaults()
+	model.Size = basetypes.NewInt64Value(int64(fileInfo.SizeBytes))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *archiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model archiveModel
+
+	if diags := req.Plan.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Any change to the archive requires a replacement, only the polling configuration can be updated
+	model.populateDefaults()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *archiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model archiveModel
+
+	if diags := req.State.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	model.populateDefaults()
+
+	task, diags := providerdata.GetCurrentTask(ctx, resp.Private)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if task != nil {
+		tflog.Info(ctx, "Deleting the pending task...", map[string]interface{}{
+			"task.id": task.ID.ValueInt64(),
+		})
+
+		if err := stopAndDeleteFileSystemTask(ctx, v.client, task.ID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("Failed to stop and delete file system task", fmt.Sprintf("Task %d, Error: %s", task.ID.ValueInt64(), err.Error()))
+			return
+		}
+
+		if diags := providerdata.UnsetCurrentTask(ctx, resp.Private); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	polling, diags := model.polling(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var deletePolling models.Polling
+
+	if diags := polling.Delete.As(ctx, &deletePolling, basetypes.ObjectAsOptions{}); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Info(ctx, "Deleting the archive...", map[string]interface{}{
+		"path": model.DestinationPath.ValueString(),
+	})
+
+	if diags := deleteFilesIfExist(ctx, resp.Private, v.client, deletePolling, model.DestinationPath.ValueString()); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// sourceChecksums hashes the source files of the archive, directories are skipped.
+func (v *archiveResource) sourceChecksums(ctx context.Context, private providerdata.Setter, model *archiveModel, polling archivePollingModel) (checksums basetypes.MapValue, diagnostics diag.Diagnostics) {
+	checksums = basetypes.NewMapNull(types.StringType)
+
+	sources, diags := model.sourcePaths(ctx)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	var checksumPolling models.Polling
+
+	if diags := polling.ChecksumCompute.As(ctx, &checksumPolling, basetypes.ObjectAsOptions{}); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	values := make(map[string]attr.Value, len(sources))
+	for _, source := range sources {
+		fileInfo, err := v.client.GetFileInfo(ctx, source)
+		if err != nil {
+			diagnostics.AddError("Failed to get source file", fmt.Sprintf("Path: %s, Error: %s", source, err.Error()))
+			return
+		}
+
+		if fileInfo.Type == freeboxTypes.FileTypeDirectory {
+			continue
+		}
+
+		hash, diags := hashFile(ctx, private, v.client, source, string(freeboxTypes.HashTypeSHA256), checksumPolling)
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+
+		values[source] = basetypes.NewStringValue(fmt.Sprintf("%s:%s", freeboxTypes.HashTypeSHA256, hash))
+	}
+
+	checksums, diags = basetypes.NewMapValue(types.StringType, values)
+	diagnostics.Append(diags...)
+
+	return
+}
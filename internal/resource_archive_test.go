@@ -0,0 +1,127 @@
+package internal_test
+
+import (
+	"errors"
+	"fmt"
+	go_path "path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe(`resource "freebox_archive" { ... }`, func() {
+	var (
+		resName     string
+		directory   string
+		source      string
+		destination string
+		config      string
+
+		upload func(ctx SpecContext, content string)
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+		directory = go_path.Join(root, existingDisk.directory, resName)
+		source = go_path.Join(directory, "config.json")
+		destination = go_path.Join(directory, "backup.zip")
+
+		upload = func(ctx SpecContext, content string) {
+			writer, taskID, err := freeboxClient.FileUploadStart(ctx, freeboxTypes.FileUploadStartActionInput{
+				Size:     len(content),
+				Dirname:  freeboxTypes.Base64Path(directory),
+				Filename: go_path.Base(source),
+				Force:    freeboxTypes.FileUploadStartActionForceOverwrite,
+			})
+			Expect(err).To(BeNil())
+
+			Expect(gbytes.TimeoutWriter(writer, 30*time.Second).Write([]byte(content))).To(Equal(len(content)))
+			Expect(gbytes.TimeoutCloser(writer, 30*time.Second).Close()).To(Succeed())
+
+			Eventually(func() freeboxTypes.UploadTask {
+				uploadTask, err := freeboxClient.GetUploadTask(ctx, int64(taskID))
+				if errors.Is(err, client.ErrTaskNotFound) { // The task is deleted by the time we get it
+					return freeboxTypes.UploadTask{
+						Status: freeboxTypes.UploadTaskStatusDone,
+					}
+				}
+				Expect(err).To(BeNil())
+				return uploadTask
+			}, "30s").Should(MatchFields(IgnoreExtras, Fields{
+				"Status": BeEquivalentTo(freeboxTypes.UploadTaskStatusDone),
+			}))
+
+			Expect(freeboxClient.DeleteUploadTask(ctx, int64(taskID))).To(Or(Succeed(), MatchError(client.ErrTaskNotFound)))
+		}
+
+		_, err := freeboxClient.CreateDirectory(ctx, go_path.Join(root, existingDisk.directory), resName)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, _ = freeboxClient.RemoveFiles(ctx, []string{directory})
+		})
+
+		upload(ctx, `{"version": 1}`)
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_archive" "` + resName + `" {
+				destination_path = "` + destination + `"
+				source_paths     = ["` + source + `"]
+			}
+		`
+	})
+
+	It("should create the archive and re-create it when the sources change", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_archive."+resName, "destination_path", destination),
+						resource.TestCheckResourceAttr("freebox_archive."+resName, "source_checksums.%", "1"),
+						resource.TestCheckResourceAttrSet("freebox_archive."+resName, "source_checksums."+source),
+						func(s *terraform.State) error {
+							fileInfo, err := freeboxClient.GetFileInfo(ctx, destination)
+							Expect(err).To(BeNil())
+							Expect(s.RootModule().Resources["freebox_archive."+resName].Primary.Attributes["size"]).To(BeEquivalentTo(fmt.Sprint(fileInfo.SizeBytes)))
+							return nil
+						},
+					),
+				},
+				{
+					PreConfig: func() {
+						upload(ctx, `{"version": 2}`)
+					},
+					Config: config,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("freebox_archive."+resName, plancheck.ResourceActionReplace),
+						},
+					},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				_, err := freeboxClient.GetFileInfo(ctx, destination)
+				Expect(err).To(MatchError(client.ErrPathNotFound))
+
+				_, err = freeboxClient.GetFileInfo(ctx, source)
+				Expect(err).To(BeNil(), "the sources should be left untouched")
+				return nil
+			},
+		})
+	})
+})
@@ -0,0 +1,326 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	go_path "path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ resource.Resource                = (*directoryResource)(nil)
+	_ resource.ResourceWithImportState = (*directoryResource)(nil)
+)
+
+func NewDirectoryResource() resource.Resource {
+	return &directoryResource{}
+}
+
+// directoryResource defines the resource implementation.
+type directoryResource struct {
+	client client.Client
+}
+
+// directoryModel describes the resource data model.
+type directoryModel struct {
+	// Path is the directory path on the Freebox.
+	Path types.String `tfsdk:"path"`
+	// Parents is whether to create parent directories.
+	Parents types.Bool `tfsdk:"parents"`
+	// ForceDestroy is whether to delete the directory even if it is not empty.
+	ForceDestroy types.Bool `tfsdk:"force_destroy"`
+	// Polling is the polling configuration.
+	Polling types.Object `tfsdk:"polling"`
+}
+
+type directoryPollingModel struct {
+	// Delete is the polling configuration for delete operation.
+	Delete types.Object `tfsdk:"delete"`
+}
+
+func (o directoryPollingModel) defaults() basetypes.ObjectValue {
+	return basetypes.NewObjectValueMust(directoryPollingModel{}.AttrTypes(), map[string]attr.Value{
+		"delete": models.NewPollingSpecModel(time.Second, time.Minute),
+	})
+}
+
+func (o directoryPollingModel) ResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"delete": schema.SingleNestedAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Deletion polling configuration",
+			Attributes:          models.PollingSpecModelResourceAttributes(time.Second, time.Minute),
+			Default:             objectdefault.StaticValue(models.NewPollingSpecModel(time.Second, time.Minute)),
+		},
+	}
+}
+
+func (o directoryPollingModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"delete": types.ObjectType{}.WithAttributeTypes(models.Polling{}.AttrTypes()),
+	}
+}
+
+func (v *directoryModel) populateDefaults() {
+	if v.Parents.IsUnknown() || v.Parents.IsNull() {
+		v.Parents = basetypes.NewBoolValue(true)
+	}
+	if v.ForceDestroy.IsUnknown() || v.ForceDestroy.IsNull() {
+		v.ForceDestroy = basetypes.NewBoolValue(false)
+	}
+	if v.Polling.IsUnknown() || v.Polling.IsNull() {
+		v.Polling = directoryPollingModel{}.defaults()
+	}
+}
+
+func (v *directoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory"
+}
+
+func (v *directoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a directory on the Freebox, so that a configuration can own it and clean it up on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to the directory on the Freebox",
+				Required:            true,
+				Validators: []validator.String{
+					models.FilePathValidator(path.Root("path")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parents": schema.BoolAttribute{
+				MarkdownDescription: "Whether to create the missing parent directories. They are not deleted with the directory",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the directory and all its content on destroy. When `false`, destroying a directory that is not empty fails",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"polling": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Polling configuration",
+				Attributes:          directoryPollingModel{}.ResourceAttributes(),
+				Default:             objectdefault.StaticValue(directoryPollingModel{}.defaults()),
+			},
+		},
+	}
+}
+
+func (v *directoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = client
+}
+
+func (v *directoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model directoryModel
+
+	if diags := req.Plan.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	model.populateDefaults()
+
+	directory := model.Path.ValueString()
+	parent, name := go_path.Dir(directory), go_path.Base(directory)
+	if parent == "." {
+		parent = "/" // Top level directories are relative to the root of the Freebox
+	}
+
+	if model.Parents.ValueBool() && parent != "/" {
+		if diags := createDirectories(ctx, v.client, parent); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Creating the directory...", map[string]interface{}{
+		"path": directory,
+	})
+
+	if _, err := v.client.CreateDirectory(ctx, parent, name); err != nil {
+		if errors.Is(err, client.ErrDestinationConflict) {
+			resp.Diagnostics.AddError("Directory already exists", fmt.Sprintf("Please delete the directory %q or import it into the state", directory))
+			return
+		}
+		resp.Diagnostics.AddError("Failed to create directory", fmt.Sprintf("Path: %s, Error: %s", directory, err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *directoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model directoryModel
+
+	if diags := req.State.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	fileInfo, err := v.client.GetFileInfo(ctx, model.Path.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrPathNotFound) {
+			tflog.Info(ctx, "Directory not found", map[string]interface{}{
+				"path": model.Path.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to get directory", fmt.Sprintf("Path: %s, Error: %s", model.Path.ValueString(), err.Error()))
+		return
+	}
+
+	if fileInfo.Type != freeboxTypes.FileTypeDirectory {
+		resp.Diagnostics.AddError("Not a directory", fmt.Sprintf("Path: %s, Type: %s", model.Path.ValueString(), string(fileInfo.Type)))
+		return
+	}
+
+	model.populateDefaults()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *directoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model directoryModel
+
+	if diags := req.Plan.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The path requires a replacement, the other attributes only change the provider behavior
+	model.populateDefaults()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *directoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model directoryModel
+
+	if diags := req.State.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	model.populateDefaults()
+
+	directory := model.Path.ValueString()
+
+	if !model.ForceDestroy.ValueBool() {
+		if diags := v.checkEmpty(ctx, directory); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	var polling directoryPollingModel
+
+	if diags := model.Polling.As(ctx, &polling, basetypes.ObjectAsOptions{}); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var deletePolling models.Polling
+
+	if diags := polling.Delete.As(ctx, &deletePolling, basetypes.ObjectAsOptions{}); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Info(ctx, "Deleting the directory...", map[string]interface{}{
+		"path":          directory,
+		"force_destroy": model.ForceDestroy.ValueBool(),
+	})
+
+	if diags := deleteFilesIfExist(ctx, resp.Private, v.client, deletePolling, directory); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// checkEmpty fails when the directory still holds files, so that they are not deleted without force_destroy.
+func (v *directoryResource) checkEmpty(ctx context.Context, directory string) (diagnostics diag.Diagnostics) {
+	entries, err := v.client.ListFiles(ctx, directory)
+	if err != nil {
+		if errors.Is(err, client.ErrPathNotFound) {
+			return
+		}
+		diagnostics.AddError("Failed to list directory", fmt.Sprintf("Path: %s, Error: %s", directory, err.Error()))
+		return
+	}
+
+	for _, entry := range entries {
+		if entry.Name == "." || entry.Name == ".." {
+			continue
+		}
+
+		diagnostics.AddError(
+			"Directory not empty",
+			fmt.Sprintf("Path: %s. Remove its content or set force_destroy to true to delete the directory with all its content", directory),
+		)
+		return
+	}
+
+	return
+}
+
+func (v *directoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fileInfo, err := v.client.GetFileInfo(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get directory", fmt.Sprintf("Path: %s, Error: %s", req.ID, err.Error()))
+		return
+	}
+
+	if fileInfo.Type != freeboxTypes.FileTypeDirectory {
+		resp.Diagnostics.AddError("Not a directory", fmt.Sprintf("Path: %s, Type: %s", req.ID, string(fileInfo.Type)))
+		return
+	}
+
+	model := directoryModel{
+		Path: basetypes.NewStringValue(req.ID),
+	}
+
+	model.populateDefaults()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,114 @@
+package internal_test
+
+import (
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nikolalohinski/free-go/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_directory" { ... }`, func() {
+	var (
+		resName      string
+		directory    string
+		forceDestroy string
+		config       string
+	)
+
+	BeforeEach(func() {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+		directory = path.Join(root, existingDisk.directory, resName, "nested")
+		forceDestroy = "false"
+	})
+
+	JustBeforeEach(func(ctx SpecContext) {
+		config = providerBlock + `
+			resource "freebox_directory" "` + resName + `" {
+				path          = "` + directory + `"
+				force_destroy = ` + forceDestroy + `
+			}
+		`
+
+		DeferCleanup(func(ctx SpecContext) {
+			// The parent directories are not owned by the resource
+			_, _ = freeboxClient.RemoveFiles(ctx, []string{path.Join(root, existingDisk.directory, resName)})
+		})
+	})
+
+	It("should create, import and delete the directory with its parents", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_directory."+resName, "path", directory),
+						resource.TestCheckResourceAttr("freebox_directory."+resName, "parents", "true"),
+						resource.TestCheckResourceAttr("freebox_directory."+resName, "force_destroy", "false"),
+						func(s *terraform.State) error {
+							fileInfo, err := freeboxClient.GetFileInfo(ctx, directory)
+							Expect(err).To(BeNil())
+							Expect(fileInfo.Type).To(BeEquivalentTo("dir"))
+							return nil
+						},
+					),
+				},
+				{
+					Config:                               config,
+					ResourceName:                         "freebox_directory." + resName,
+					ImportState:                          true,
+					ImportStateId:                        directory,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "path",
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				_, err := freeboxClient.GetFileInfo(ctx, directory)
+				Expect(err).To(MatchError(client.ErrPathNotFound))
+
+				_, err = freeboxClient.GetFileInfo(ctx, path.Dir(directory))
+				Expect(err).To(BeNil(), "the parent directory should be left untouched")
+				return nil
+			},
+		})
+	})
+
+	Context("when force_destroy is true", func() {
+		BeforeEach(func() {
+			forceDestroy = "true"
+		})
+
+		It("should delete the directory with its content", func(ctx SpecContext) {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("freebox_directory."+resName, "force_destroy", "true"),
+							func(s *terraform.State) error {
+								_, err := freeboxClient.CreateDirectory(ctx, directory, "content")
+								Expect(err).To(BeNil())
+								return nil
+							},
+						),
+					},
+				},
+				CheckDestroy: func(s *terraform.State) error {
+					_, err := freeboxClient.GetFileInfo(ctx, path.Join(directory, "content"))
+					Expect(err).To(MatchError(client.ErrPathNotFound))
+
+					_, err = freeboxClient.GetFileInfo(ctx, directory)
+					Expect(err).To(MatchError(client.ErrPathNotFound))
+					return nil
+				},
+			})
+		})
+	})
+})
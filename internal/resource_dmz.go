@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ resource.ResourceWithImportState = &dmzResource{}
+)
+
+func NewDMZResource() resource.Resource {
+	return &dmzResource{}
+}
+
+type dmzResource struct {
+	client client.Client
+}
+
+type dmzModel struct {
+	ID       types.String `tfsdk:"id"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	TargetIP types.String `tfsdk:"target_ip"`
+}
+
+func (m *dmzModel) toPayload() freeboxTypes.DMZConfig {
+	return freeboxTypes.DMZConfig{
+		Enabled: m.Enabled.ValueBool(),
+		IP:      m.TargetIP.ValueString(),
+	}
+}
+
+func (m *dmzModel) fromClientType(config freeboxTypes.DMZConfig) {
+	m.ID = basetypes.NewStringValue("dmz")
+	m.Enabled = basetypes.NewBoolValue(config.Enabled)
+	m.TargetIP = basetypes.NewStringValue(config.IP)
+}
+
+func (v *dmzResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dmz"
+}
+
+func (v *dmzResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DMZ (demilitarized zone) of the Freebox firewall: every incoming connection that does not match a port forwarding rule is forwarded to the target host. This is a singleton resource: only one DMZ exists per Freebox. Destroying this resource disables the DMZ.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier for the singleton DMZ resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DMZ is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"target_ip": schema.StringAttribute{
+				MarkdownDescription: "Local IPv4 address of the host to forward the incoming traffic to",
+				Required:            true,
+				Validators: []validator.String{
+					models.IPv4Validator(),
+				},
+			},
+		},
+	}
+}
+
+func (v *dmzResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *dmzResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model dmzModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.UpdateDMZConfig(ctx, model.toPayload())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure DMZ",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dmzResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model dmzModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.GetDMZConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read DMZ config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dmzResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model dmzModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.UpdateDMZConfig(ctx, model.toPayload())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update DMZ config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dmzResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model dmzModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := model.toPayload()
+	payload.Enabled = false
+
+	if _, err := v.client.UpdateDMZConfig(ctx, payload); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable DMZ",
+			err.Error(),
+		)
+	}
+}
+
+func (v *dmzResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "dmz")...)
+}
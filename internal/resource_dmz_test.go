@@ -0,0 +1,104 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_dmz" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		targetIP       string
+		newTargetIP    string
+		originalConfig freeboxTypes.DMZConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		subnet := randGenerator.Int63n(200-2) + 2
+		targetIP = fmt.Sprintf("192.168.1.%d", subnet)
+		newTargetIP = fmt.Sprintf("192.168.1.%d", subnet+1)
+
+		var err error
+		originalConfig, err = freeboxClient.GetDMZConfig(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateDMZConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original DMZ config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		// The DMZ is kept disabled to avoid exposing any host of the network while testing
+		config = providerBlock + `
+			resource "freebox_dmz" "` + resName + `" {
+				enabled   = false
+				target_ip = "` + targetIP + `"
+			}
+		`
+	})
+
+	It("should create, update and delete the DMZ configuration", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_dmz."+resName, "id", "dmz"),
+						resource.TestCheckResourceAttr("freebox_dmz."+resName, "enabled", "false"),
+						resource.TestCheckResourceAttr("freebox_dmz."+resName, "target_ip", targetIP),
+						func(s *terraform.State) error {
+							dmz, err := freeboxClient.GetDMZConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(dmz.Enabled).To(BeFalse())
+							Expect(dmz.IP).To(Equal(targetIP))
+							return nil
+						},
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("target_ip", newTargetIP)(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_dmz."+resName, "target_ip", newTargetIP),
+						func(s *terraform.State) error {
+							dmz, err := freeboxClient.GetDMZConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(dmz.IP).To(Equal(newTargetIP))
+							return nil
+						},
+					),
+				},
+				{
+					Config:        config,
+					ResourceName:  "freebox_dmz." + resName,
+					ImportState:   true,
+					ImportStateId: "dmz",
+					ImportStateCheck: func(states []*terraform.InstanceState) error {
+						Expect(states).To(HaveLen(1))
+						Expect(states[0].ID).To(Equal("dmz"))
+						Expect(states[0].Attributes["target_ip"]).To(Equal(newTargetIP))
+						return nil
+					},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				dmz, err := freeboxClient.GetDMZConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(dmz.Enabled).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
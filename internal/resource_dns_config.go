@@ -0,0 +1,222 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ resource.ResourceWithImportState = &dnsConfigResource{}
+)
+
+// dnsConfigMaxServers is the number of DNS servers the DHCP server of the Freebox can push.
+const dnsConfigMaxServers = 5
+
+func NewDNSConfigResource() resource.Resource {
+	return &dnsConfigResource{}
+}
+
+type dnsConfigResource struct {
+	client client.Client
+}
+
+type dnsConfigModel struct {
+	ID      types.String `tfsdk:"id"`
+	Servers types.List   `tfsdk:"servers"`
+}
+
+// toPayload applies the values of the model on top of the current DHCP configuration.
+func (m *dnsConfigModel) toPayload(ctx context.Context, current freeboxTypes.DHCPConfig) (freeboxTypes.DHCPConfig, diag.Diagnostics) {
+	payload := current
+
+	var servers []string
+	if diags := m.Servers.ElementsAs(ctx, &servers, false); diags.HasError() {
+		return payload, diags
+	}
+
+	payload.DNS = servers
+
+	return payload, nil
+}
+
+// fromClientType sets the model from the DHCP configuration. The Freebox pads the list of
+// servers with empty strings, which are dropped.
+func (m *dnsConfigModel) fromClientType(ctx context.Context, config freeboxTypes.DHCPConfig) (diagnostics diag.Diagnostics) {
+	servers := make([]string, 0, len(config.DNS))
+	for _, server := range config.DNS {
+		if server != "" {
+			servers = append(servers, server)
+		}
+	}
+
+	m.ID = basetypes.NewStringValue("dns")
+	m.Servers, diagnostics = basetypes.NewListValueFrom(ctx, types.StringType, servers)
+
+	return
+}
+
+func (v *dnsConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_config"
+}
+
+func (v *dnsConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DNS servers pushed by the DHCP server of the Freebox to the hosts of the local network. This is a singleton resource: only one DNS configuration exists per Freebox. Destroying this resource makes the Freebox push its own address again. The Freebox does not support custom local DNS entries: the local names of the hosts are managed with `freebox_lan_host`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier for the singleton DNS configuration resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"servers": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("IPv4 addresses of the DNS servers pushed to the hosts of the local network, by order of preference (at most %d)", dnsConfigMaxServers),
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, dnsConfigMaxServers),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						models.IPv4Validator(),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (v *dnsConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *dnsConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model dnsConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dnsConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model dnsConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := v.client.GetDHCPConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read DHCP configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(model.fromClientType(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dnsConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model dnsConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dnsConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	config, err := v.client.GetDHCPConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read DHCP configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	config.DNS = []string{config.Gateway}
+
+	if _, err := v.client.UpdateDHCPConfig(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reset DNS servers",
+			err.Error(),
+		)
+	}
+}
+
+func (v *dnsConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "dns")...)
+}
+
+// apply merges the DNS servers into the DHCP configuration of the Freebox.
+func (v *dnsConfigResource) apply(ctx context.Context, model *dnsConfigModel) (diagnostics diag.Diagnostics) {
+	current, err := v.client.GetDHCPConfig(ctx)
+	if err != nil {
+		diagnostics.AddError("Failed to read DHCP configuration", err.Error())
+		return
+	}
+
+	payload, diags := model.toPayload(ctx, current)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	config, err := v.client.UpdateDHCPConfig(ctx, payload)
+	if err != nil {
+		diagnostics.AddError("Failed to update DNS servers", err.Error())
+		return
+	}
+
+	return model.fromClientType(ctx, config)
+}
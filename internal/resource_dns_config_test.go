@@ -0,0 +1,92 @@
+package internal_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_dns_config" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		originalConfig freeboxTypes.DHCPConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		originalConfig, err = freeboxClient.GetDHCPConfig(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateDHCPConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original DHCP config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_dns_config" "` + resName + `" {
+				servers = ["1.1.1.1", "9.9.9.9"]
+			}
+		`
+	})
+
+	It("should configure, update, import and reset the DNS servers", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_dns_config."+resName, "id", "dns"),
+						resource.TestCheckResourceAttr("freebox_dns_config."+resName, "servers.#", "2"),
+						resource.TestCheckResourceAttr("freebox_dns_config."+resName, "servers.0", "1.1.1.1"),
+						resource.TestCheckResourceAttr("freebox_dns_config."+resName, "servers.1", "9.9.9.9"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetDHCPConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.DNS).To(ContainElements("1.1.1.1", "9.9.9.9"))
+							return nil
+						},
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("servers", []string{"9.9.9.9"})(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_dns_config."+resName, "servers.#", "1"),
+						resource.TestCheckResourceAttr("freebox_dns_config."+resName, "servers.0", "9.9.9.9"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetDHCPConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.DNS).ToNot(ContainElement("1.1.1.1"))
+							return nil
+						},
+					),
+				},
+				{
+					Config:            terraformConfigWithAttribute("servers", []string{"9.9.9.9"})(config),
+					ResourceName:      "freebox_dns_config." + resName,
+					ImportState:       true,
+					ImportStateId:     "dns",
+					ImportStateVerify: true,
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetDHCPConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(config.DNS).To(ContainElement(config.Gateway))
+				Expect(config.DNS).ToNot(ContainElement("9.9.9.9"))
+				return nil
+			},
+		})
+	})
+})
@@ -0,0 +1,307 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.Resource                = &dynDNSResource{}
+	_ resource.ResourceWithImportState = &dynDNSResource{}
+)
+
+// dynDNSServices lists the dynamic DNS services supported by the Freebox.
+var dynDNSServices = []string{
+	"dyndns",
+	"noip",
+	"ovh",
+}
+
+// dynDNSHealthyStatuses are the statuses of the status endpoint that do not report an error.
+var dynDNSHealthyStatuses = []string{
+	"ok",
+	"wait",
+	"disabled",
+}
+
+func NewDynDNSResource() resource.Resource {
+	return &dynDNSResource{}
+}
+
+// dynDNSResource defines the resource implementation.
+type dynDNSResource struct {
+	client client.Client
+}
+
+// dynDNSModel describes the resource data model.
+type dynDNSModel struct {
+	ID          types.String      `tfsdk:"id"`
+	Service     types.String      `tfsdk:"service"`
+	Enabled     types.Bool        `tfsdk:"enabled"`
+	Hostname    types.String      `tfsdk:"hostname"`
+	User        types.String      `tfsdk:"user"`
+	Password    types.String      `tfsdk:"password"`
+	Status      types.String      `tfsdk:"status"`
+	Error       types.String      `tfsdk:"error"`
+	LastRefresh timetypes.RFC3339 `tfsdk:"last_refresh"`
+	NextRefresh timetypes.RFC3339 `tfsdk:"next_refresh"`
+	LastError   timetypes.RFC3339 `tfsdk:"last_error"`
+}
+
+func (m *dynDNSModel) toPayload() freeboxTypes.DynamicDNSConfig {
+	return freeboxTypes.DynamicDNSConfig{
+		Enabled:  m.Enabled.ValueBool(),
+		Hostname: m.Hostname.ValueString(),
+		User:     m.User.ValueString(),
+		Password: m.Password.ValueString(),
+	}
+}
+
+// fromClientType sets the model from the configuration of the service. The password is
+// never returned by the Freebox, so the one of the model is kept.
+func (m *dynDNSModel) fromClientType(config freeboxTypes.DynamicDNSConfig) {
+	m.ID = m.Service
+	m.Enabled = basetypes.NewBoolValue(config.Enabled)
+	m.Hostname = basetypes.NewStringValue(config.Hostname)
+	m.User = basetypes.NewStringValue(config.User)
+}
+
+func (m *dynDNSModel) fromStatus(status freeboxTypes.DynamicDNSStatus) {
+	m.Status = basetypes.NewStringValue(status.Status)
+	if status.Status == "" || slices.Contains(dynDNSHealthyStatuses, status.Status) {
+		m.Error = basetypes.NewStringNull()
+	} else {
+		m.Error = basetypes.NewStringValue(status.Status)
+	}
+	m.LastRefresh = dynDNSTimestamp(status.LastRefresh)
+	m.NextRefresh = dynDNSTimestamp(status.NextRefresh)
+	m.LastError = dynDNSTimestamp(status.LastError)
+}
+
+// dynDNSTimestamp converts a UNIX timestamp of the status endpoint, where 0 means never.
+func dynDNSTimestamp(timestamp int64) timetypes.RFC3339 {
+	if timestamp == 0 {
+		return timetypes.NewRFC3339Null()
+	}
+	return timetypes.NewRFC3339TimeValue(time.Unix(timestamp, 0).UTC())
+}
+
+func (v *dynDNSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dyndns"
+}
+
+func (v *dynDNSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the dynamic DNS updates of the Freebox for one service. The configuration of each service always exists on the Freebox: destroying this resource disables the updates.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the resource, which is the name of the service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Dynamic DNS service: `dyndns`, `noip` or `ovh`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dynDNSServices...),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the dynamic DNS updates are enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname to update with the public IP address of the Freebox",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User name of the account on the service",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the account on the service. It is hidden from the plan output but stored in the Terraform state",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the last update (e.g. `ok`, `bad_auth`, `nohost`, `disabled`)",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "Error reported by the status endpoint for the last update (e.g. `bad_auth`, `nohost`), null when it did not fail",
+				Computed:            true,
+			},
+			"last_refresh": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Time of the last successful update",
+				Computed:            true,
+			},
+			"next_refresh": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Time of the next scheduled update",
+				Computed:            true,
+			},
+			"last_error": schema.StringAttribute{
+				CustomType:          timetypes.RFC3339Type{},
+				MarkdownDescription: "Time of the last failed update, see `error` for the reason when the last update failed",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (v *dynDNSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *dynDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model dynDNSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dynDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model dynDNSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := v.client.GetDynamicDNSConfig(ctx, model.Service.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read dynamic DNS configuration",
+			fmt.Sprintf("Failed to read dynamic DNS configuration of %q: %s", model.Service.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(config)
+
+	resp.Diagnostics.Append(v.readStatus(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dynDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model dynDNSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *dynDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model dynDNSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the state is sent: the password is never returned by the Freebox and would be wiped
+	if _, err := v.client.UpdateDynamicDNSConfig(ctx, model.Service.ValueString(), freeboxTypes.DynamicDNSConfig{Enabled: false}); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable dynamic DNS",
+			fmt.Sprintf("Failed to disable dynamic DNS of %q: %s", model.Service.ValueString(), err),
+		)
+	}
+}
+
+func (v *dynDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), req.ID)...)
+}
+
+// apply pushes the configuration of the service to the Freebox and reads back its status.
+func (v *dynDNSResource) apply(ctx context.Context, model *dynDNSModel) (diagnostics diag.Diagnostics) {
+	config, err := v.client.UpdateDynamicDNSConfig(ctx, model.Service.ValueString(), model.toPayload())
+	if err != nil {
+		diagnostics.AddError("Failed to update dynamic DNS configuration", fmt.Sprintf("Failed to update dynamic DNS configuration of %q: %s", model.Service.ValueString(), err))
+		return
+	}
+
+	model.fromClientType(config)
+
+	return v.readStatus(ctx, model)
+}
+
+func (v *dynDNSResource) readStatus(ctx context.Context, model *dynDNSModel) (diagnostics diag.Diagnostics) {
+	status, err := v.client.GetDynamicDNSStatus(ctx, model.Service.ValueString())
+	if err != nil {
+		diagnostics.AddError("Failed to read dynamic DNS status", fmt.Sprintf("Failed to read dynamic DNS status of %q: %s", model.Service.ValueString(), err))
+		return
+	}
+
+	model.fromStatus(status)
+
+	return nil
+}
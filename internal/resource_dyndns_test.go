@@ -0,0 +1,99 @@
+package internal_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_dyndns" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		hostname       string
+		originalConfig freeboxTypes.DynamicDNSConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+		hostname = resName + ".example.org"
+
+		var err error
+		originalConfig, err = freeboxClient.GetDynamicDNSConfig(ctx, "noip")
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateDynamicDNSConfig(ctx, "noip", originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original dynamic DNS config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_dyndns" "` + resName + `" {
+				service  = "noip"
+				hostname = "` + hostname + `"
+				user     = "` + resName + `"
+				password = "` + resName + `"
+			}
+		`
+	})
+
+	It("should configure, update, import and disable the dynamic DNS", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_dyndns."+resName, "id", "noip"),
+						resource.TestCheckResourceAttr("freebox_dyndns."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_dyndns."+resName, "hostname", hostname),
+						resource.TestCheckResourceAttr("freebox_dyndns."+resName, "user", resName),
+						resource.TestCheckResourceAttrSet("freebox_dyndns."+resName, "status"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetDynamicDNSConfig(ctx, "noip")
+							Expect(err).To(BeNil())
+							Expect(config.Enabled).To(BeTrue())
+							Expect(config.Hostname).To(Equal(hostname))
+							Expect(config.User).To(Equal(resName))
+							return nil
+						},
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("hostname", "updated-"+hostname)(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_dyndns."+resName, "hostname", "updated-"+hostname),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetDynamicDNSConfig(ctx, "noip")
+							Expect(err).To(BeNil())
+							Expect(config.Hostname).To(Equal("updated-" + hostname))
+							return nil
+						},
+					),
+				},
+				{
+					Config:                  terraformConfigWithAttribute("hostname", "updated-"+hostname)(config),
+					ResourceName:            "freebox_dyndns." + resName,
+					ImportState:             true,
+					ImportStateId:           "noip",
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"password", "status", "last_refresh", "next_refresh", "last_error", "error"},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetDynamicDNSConfig(ctx, "noip")
+				Expect(err).To(BeNil())
+				Expect(config.Enabled).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
@@ -0,0 +1,327 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &ftpConfigResource{}
+)
+
+func NewFTPConfigResource() resource.Resource {
+	return &ftpConfigResource{}
+}
+
+type ftpConfigResource struct {
+	client client.Client
+}
+
+type ftpConfigModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	AllowAnonymous      types.Bool   `tfsdk:"allow_anonymous"`
+	AllowAnonymousWrite types.Bool   `tfsdk:"allow_anonymous_write"`
+	Password            types.String `tfsdk:"password"`
+	PasswordVersion     types.Int64  `tfsdk:"password_version"`
+	RejectWeakPassword  types.Bool   `tfsdk:"reject_weak_password"`
+	AllowRemoteAccess   types.Bool   `tfsdk:"allow_remote_access"`
+	PortCtrl            types.Int64  `tfsdk:"port_ctrl"`
+	PortData            types.Int64  `tfsdk:"port_data"`
+	RemoteDomain        types.String `tfsdk:"remote_domain"`
+}
+
+// toPayload applies the values of the model on top of the current FTP configuration.
+func (m *ftpConfigModel) toPayload(current freeboxTypes.FTPConfig) freeboxTypes.FTPConfig {
+	payload := current
+	payload.Enabled = m.Enabled.ValueBool()
+	payload.AllowAnonymous = m.AllowAnonymous.ValueBool()
+	payload.AllowAnonymousWrite = m.AllowAnonymousWrite.ValueBool()
+	payload.AllowRemoteAccess = m.AllowRemoteAccess.ValueBool()
+
+	// The password is write-only, it is only known when read from the configuration
+	if !m.Password.IsNull() && !m.Password.IsUnknown() {
+		payload.Password = m.Password.ValueString()
+	}
+	if !m.PortCtrl.IsNull() && !m.PortCtrl.IsUnknown() {
+		payload.PortCtrl = m.PortCtrl.ValueInt64()
+	}
+	if !m.PortData.IsNull() && !m.PortData.IsUnknown() {
+		payload.PortData = m.PortData.ValueInt64()
+	}
+
+	return payload
+}
+
+// fromClientType sets the model from the FTP configuration. The password is never
+// returned by the Freebox and is write-only, so it is left untouched.
+func (m *ftpConfigModel) fromClientType(config freeboxTypes.FTPConfig) {
+	m.ID = basetypes.NewStringValue("ftp")
+	m.Enabled = basetypes.NewBoolValue(config.Enabled)
+	m.AllowAnonymous = basetypes.NewBoolValue(config.AllowAnonymous)
+	m.AllowAnonymousWrite = basetypes.NewBoolValue(config.AllowAnonymousWrite)
+	m.AllowRemoteAccess = basetypes.NewBoolValue(config.AllowRemoteAccess)
+	m.PortCtrl = basetypes.NewInt64Value(config.PortCtrl)
+	m.PortData = basetypes.NewInt64Value(config.PortData)
+	m.RemoteDomain = basetypes.NewStringValue(config.RemoteDomain)
+	if m.RejectWeakPassword.IsNull() {
+		m.RejectWeakPassword = basetypes.NewBoolValue(true)
+	}
+}
+
+func (v *ftpConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ftp_config"
+}
+
+func (v *ftpConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the FTP server of the Freebox, giving access to its storage. This is a singleton resource: only one FTP server exists per Freebox. Destroying this resource disables the FTP server and its remote access.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier for the singleton FTP configuration resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the FTP server is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"allow_anonymous": schema.BoolAttribute{
+				MarkdownDescription: "Whether anonymous users can log in",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_anonymous_write": schema.BoolAttribute{
+				MarkdownDescription: "Whether anonymous users can write files",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the `freebox` user of the FTP server. It is write-only: sent to the Freebox but never stored in the Terraform state, which requires Terraform 1.11 or later. Changing it alone is not detected, bump `password_version` to push a new password",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the password, to change whenever `password` changes so that the new password is pushed to the Freebox",
+				Optional:            true,
+			},
+			"reject_weak_password": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fail when the Freebox considers the password weak. Checked when the FTP server is created or `password_version` changes. The Freebox only checks the password once it is set and the previous one can not be restored, so a new password is first set with the FTP server and its remote access disabled, and they stay disabled until a stronger password is applied",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"allow_remote_access": schema.BoolAttribute{
+				MarkdownDescription: "Whether the FTP server can be reached from the Internet",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"port_ctrl": schema.Int64Attribute{
+				MarkdownDescription: "Port of the control connection used for the remote access. If not set, the current port is kept",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"port_data": schema.Int64Attribute{
+				MarkdownDescription: "Port of the data connection used for the remote access. If not set, the current port is kept",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"remote_domain": schema.StringAttribute{
+				MarkdownDescription: "Domain name to reach the FTP server from the Internet",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (v *ftpConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *ftpConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model ftpConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &model.Password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *ftpConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model ftpConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.GetFTPConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read FTP config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *ftpConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state ftpConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &model.Password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model, !model.PasswordVersion.Equal(state.PasswordVersion))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *ftpConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	config, err := v.client.GetFTPConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read FTP config",
+			err.Error(),
+		)
+		return
+	}
+
+	config.Enabled = false
+	config.AllowRemoteAccess = false
+
+	if _, err := v.client.UpdateFTPConfig(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable FTP server",
+			err.Error(),
+		)
+	}
+}
+
+func (v *ftpConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "ftp")...)
+}
+
+// apply merges the planned configuration into the current one of the FTP server and pushes it to the Freebox.
+// The Freebox only reports a weak password once it is set, and never returns the previous one. So when
+// weak passwords are rejected, a new password is first set with the FTP server and its remote access
+// disabled, and they stay disabled when the password is weak.
+func (v *ftpConfigResource) apply(ctx context.Context, model *ftpConfigModel, passwordChanged bool) (diagnostics diag.Diagnostics) {
+	current, err := v.client.GetFTPConfig(ctx)
+	if err != nil {
+		diagnostics.AddError("Failed to read FTP config", err.Error())
+		return
+	}
+
+	if passwordChanged && model.RejectWeakPassword.ValueBool() {
+		payload := model.toPayload(current)
+		payload.Enabled = false
+		payload.AllowRemoteAccess = false
+
+		current, err = v.client.UpdateFTPConfig(ctx, payload)
+		if err != nil {
+			diagnostics.AddError("Failed to update FTP password", err.Error())
+			return
+		}
+
+		if current.WeakPassword {
+			diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Weak FTP password",
+				"The Freebox considers the password weak, the FTP server has been disabled. Choose a stronger password and bump password_version, or set reject_weak_password to false.",
+			)
+			return
+		}
+	}
+
+	config, err := v.client.UpdateFTPConfig(ctx, model.toPayload(current))
+	if err != nil {
+		diagnostics.AddError("Failed to update FTP config", err.Error())
+		return
+	}
+
+	model.fromClientType(config)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package internal_test
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_ftp_config" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		password       string
+		originalConfig freeboxTypes.FTPConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+		password = "Aa1!" + uuid.New().String()
+
+		var err error
+		originalConfig, err = freeboxClient.GetFTPConfig(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateFTPConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original FTP config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		// The remote access is kept disabled to avoid exposing the storage of the Freebox while testing
+		config = providerBlock + `
+			resource "freebox_ftp_config" "` + resName + `" {
+				enabled             = true
+				allow_anonymous     = false
+				allow_remote_access = false
+				password            = "` + password + `"
+				password_version    = 1
+			}
+		`
+	})
+
+	It("should configure, update, import and disable the FTP server", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_ftp_config."+resName, "id", "ftp"),
+						resource.TestCheckResourceAttr("freebox_ftp_config."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_ftp_config."+resName, "allow_anonymous", "false"),
+						resource.TestCheckResourceAttr("freebox_ftp_config."+resName, "allow_anonymous_write", "false"),
+						resource.TestCheckResourceAttrSet("freebox_ftp_config."+resName, "port_ctrl"),
+						resource.TestCheckResourceAttrSet("freebox_ftp_config."+resName, "port_data"),
+						resource.TestCheckNoResourceAttr("freebox_ftp_config."+resName, "password"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetFTPConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.Enabled).To(BeTrue())
+							Expect(config.AllowAnonymous).To(BeFalse())
+							Expect(config.AllowRemoteAccess).To(BeFalse())
+							return nil
+						},
+					),
+				},
+				{
+					Config:      terraformConfigWithAttribute("password_version", 2)(terraformConfigWithAttribute("password", "12345678")(config)),
+					ExpectError: regexp.MustCompile(`Weak FTP password`),
+				},
+				{
+					PreConfig: func() {
+						config, err := freeboxClient.GetFTPConfig(ctx)
+						Expect(err).To(BeNil())
+						Expect(config.Enabled).To(BeFalse(), "the FTP server should be disabled after a weak password")
+						Expect(config.AllowRemoteAccess).To(BeFalse())
+					},
+					Config: terraformConfigWithAttribute("allow_anonymous", true)(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_ftp_config."+resName, "allow_anonymous", "true"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetFTPConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.AllowAnonymous).To(BeTrue())
+							Expect(config.AllowAnonymousWrite).To(BeFalse())
+							return nil
+						},
+					),
+				},
+				{
+					Config:                  terraformConfigWithAttribute("allow_anonymous", true)(config),
+					ResourceName:            "freebox_ftp_config." + resName,
+					ImportState:             true,
+					ImportStateId:           "ftp",
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"password_version"},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetFTPConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(config.Enabled).To(BeFalse())
+				Expect(config.AllowRemoteAccess).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
@@ -0,0 +1,218 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &incomingPortResource{}
+)
+
+func NewIncomingPortResource() resource.Resource {
+	return &incomingPortResource{}
+}
+
+type incomingPortResource struct {
+	client client.Client
+}
+
+type incomingPortModel struct {
+	ID       types.String `tfsdk:"id"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	InPort   types.Int64  `tfsdk:"in_port"`
+	Active   types.Bool   `tfsdk:"active"`
+	Type     types.String `tfsdk:"type"`
+	Readonly types.Bool   `tfsdk:"readonly"`
+}
+
+func (m *incomingPortModel) toPayload() freeboxTypes.IncomingPortConfigPayload {
+	enabled := m.Enabled.ValueBool()
+	payload := freeboxTypes.IncomingPortConfigPayload{
+		Enabled: &enabled,
+	}
+	if !m.InPort.IsNull() && !m.InPort.IsUnknown() {
+		payload.InPort = m.InPort.ValueInt64()
+	}
+	return payload
+}
+
+func (m *incomingPortModel) fromClientType(config freeboxTypes.IncomingPortConfig) {
+	m.ID = basetypes.NewStringValue(config.ID)
+	m.Enabled = basetypes.NewBoolValue(config.Enabled)
+	m.InPort = basetypes.NewInt64Value(config.InPort)
+	m.Active = basetypes.NewBoolValue(config.Active)
+	m.Type = basetypes.NewStringValue(config.Type)
+	m.Readonly = basetypes.NewBoolValue(config.Readonly)
+}
+
+func (v *incomingPortResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incoming_port"
+}
+
+func (v *incomingPortResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the incoming port of one of the services built into the Freebox (remote access, FTP, BitTorrent, ...). The incoming ports always exist on the Freebox, one per service: destroying this resource disables the incoming port of the service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the incoming port belongs to (e.g. `http_remote_access`, `https_remote_access`, `ftp`, `bittorrent-main`, `bittorrent-dht`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the incoming port is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"in_port": schema.Int64Attribute{
+				MarkdownDescription: "Port the service listens on from the Internet. If not set, the current port of the service is kept",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the service behind the incoming port is currently running",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Protocol(s) of the incoming port (`tcp`, `udp` or `tcp_udp`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"readonly": schema.BoolAttribute{
+				MarkdownDescription: "Whether the port of the service can not be changed",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (v *incomingPortResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *incomingPortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model incomingPortModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.UpdateIncomingPort(ctx, model.ID.ValueString(), model.toPayload())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure incoming port",
+			fmt.Sprintf("Failed to configure incoming port %q: %s", model.ID.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *incomingPortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model incomingPortModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.GetIncomingPort(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read incoming port",
+			fmt.Sprintf("Failed to read incoming port %q: %s", model.ID.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *incomingPortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model incomingPortModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.UpdateIncomingPort(ctx, model.ID.ValueString(), model.toPayload())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update incoming port",
+			fmt.Sprintf("Failed to update incoming port %q: %s", model.ID.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *incomingPortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model incomingPortModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := model.toPayload()
+	*payload.Enabled = false
+
+	if _, err := v.client.UpdateIncomingPort(ctx, model.ID.ValueString(), payload); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable incoming port",
+			fmt.Sprintf("Failed to disable incoming port %q: %s", model.ID.ValueString(), err),
+		)
+	}
+}
+
+func (v *incomingPortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,91 @@
+package internal_test
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_incoming_port" { ... }`, func() {
+	const incomingPortID = "bittorrent-main"
+
+	var (
+		resName        string
+		config         string
+		newInPort      int64
+		originalConfig freeboxTypes.IncomingPortConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		originalConfig, err = freeboxClient.GetIncomingPort(ctx, incomingPortID)
+		Expect(err).To(BeNil())
+
+		newInPort = randGenerator.Int63n(60000-10000) + 10000
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateIncomingPort(ctx, incomingPortID, freeboxTypes.IncomingPortConfigPayload{
+				Enabled: &originalConfig.Enabled,
+				InPort:  originalConfig.InPort,
+			})
+			Expect(err).To(BeNil(), "failed to restore original incoming port config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_incoming_port" "` + resName + `" {
+				id      = "` + incomingPortID + `"
+				enabled = true
+				in_port = ` + strconv.FormatInt(originalConfig.InPort, 10) + `
+			}
+		`
+	})
+
+	It("should configure, update and disable the incoming port", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_incoming_port."+resName, "id", incomingPortID),
+						resource.TestCheckResourceAttr("freebox_incoming_port."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_incoming_port."+resName, "in_port", strconv.FormatInt(originalConfig.InPort, 10)),
+						resource.TestCheckResourceAttr("freebox_incoming_port."+resName, "type", originalConfig.Type),
+						resource.TestCheckResourceAttrSet("freebox_incoming_port."+resName, "active"),
+						resource.TestCheckResourceAttrSet("freebox_incoming_port."+resName, "readonly"),
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("in_port", newInPort)(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_incoming_port."+resName, "in_port", strconv.FormatInt(newInPort, 10)),
+						func(s *terraform.State) error {
+							incomingPort, err := freeboxClient.GetIncomingPort(ctx, incomingPortID)
+							Expect(err).To(BeNil())
+							Expect(incomingPort.Enabled).To(BeTrue())
+							Expect(incomingPort.InPort).To(Equal(newInPort))
+							return nil
+						},
+					),
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				incomingPort, err := freeboxClient.GetIncomingPort(ctx, incomingPortID)
+				Expect(err).To(BeNil())
+				Expect(incomingPort.Enabled).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
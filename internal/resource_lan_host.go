@@ -0,0 +1,278 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ resource.Resource                = &lanHostResource{}
+	_ resource.ResourceWithImportState = &lanHostResource{}
+)
+
+// lanHostTypes lists the host types accepted by the LAN browser API.
+var lanHostTypes = []string{
+	"workstation",
+	"laptop",
+	"smartphone",
+	"tablet",
+	"printer",
+	"vg_console",
+	"television",
+	"nas",
+	"ip_camera",
+	"ip_phone",
+	"freebox_player",
+	"freebox_hd",
+	"freebox_crystal",
+	"freebox_mini",
+	"freebox_delta",
+	"freebox_one",
+	"freebox_wifi",
+	"freebox_pop",
+	"networking_device",
+	"multimedia_device",
+	"car",
+	"other",
+}
+
+func NewLanHostResource() resource.Resource {
+	return &lanHostResource{}
+}
+
+// lanHostResource defines the resource implementation.
+type lanHostResource struct {
+	client client.Client
+}
+
+// lanHostModel describes the resource data model.
+type lanHostModel struct {
+	Interface   types.String `tfsdk:"interface"`
+	HostID      types.String `tfsdk:"host_id"`
+	PrimaryName types.String `tfsdk:"primary_name"`
+	HostType    types.String `tfsdk:"host_type"`
+	Persistent  types.Bool   `tfsdk:"persistent"`
+	LanHost     types.Object `tfsdk:"host"`
+}
+
+func (m *lanHostModel) toPayload() freeboxTypes.LanHostUpdatePayload {
+	payload := freeboxTypes.LanHostUpdatePayload{
+		ID: m.HostID.ValueString(),
+	}
+	if !m.PrimaryName.IsNull() && !m.PrimaryName.IsUnknown() {
+		payload.PrimaryName = m.PrimaryName.ValueString()
+	}
+	if !m.HostType.IsNull() && !m.HostType.IsUnknown() {
+		payload.HostType = freeboxTypes.HostType(m.HostType.ValueString())
+	}
+	if !m.Persistent.IsNull() && !m.Persistent.IsUnknown() {
+		persistent := m.Persistent.ValueBool()
+		payload.Persistent = &persistent
+	}
+	return payload
+}
+
+func (m *lanHostModel) fromClientType(host freeboxTypes.LanInterfaceHost) {
+	m.HostID = basetypes.NewStringValue(host.ID)
+	m.PrimaryName = basetypes.NewStringValue(host.PrimaryName)
+	m.HostType = basetypes.NewStringValue(string(host.Type))
+	m.Persistent = basetypes.NewBoolValue(host.Persistent)
+	m.LanHost = models.LanHostModel{}.FromClientType(host)
+}
+
+func (v *lanHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lan_host"
+}
+
+func (v *lanHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the writable settings of a host known by the LAN browser of the Freebox (name, type and persistence). Destroying this resource makes the Freebox forget the host.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Name of the LAN interface the host belongs to (e.g. `pub`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"host_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the host in the LAN browser (e.g. `ether-00:11:22:33:44:55`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"primary_name": schema.StringAttribute{
+				MarkdownDescription: "Primary name of the host. If not set, the name chosen by the Freebox is kept",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"host_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the host, overriding the one guessed by the Freebox. If not set, the current type is kept",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(lanHostTypes...),
+				},
+			},
+			"persistent": schema.BoolAttribute{
+				MarkdownDescription: "If true the host is always shown even if it has not been active since the Freebox startup. If not set, the current value is kept",
+				Optional:            true,
+				Computed:            true,
+			},
+			"host": schema.SingleNestedAttribute{
+				MarkdownDescription: "LAN host information",
+				Computed:            true,
+				Attributes:          models.LanHostModel{}.ResourceAttributes(),
+			},
+		},
+	}
+}
+
+func (v *lanHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *lanHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model lanHostModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, err := v.client.UpdateLanInterfaceHost(ctx, model.Interface.ValueString(), model.HostID.ValueString(), model.toPayload())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update LAN host",
+			fmt.Sprintf("Failed to update LAN host %q at %q: %s", model.HostID.ValueString(), model.Interface.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(host)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *lanHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model lanHostModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, err := v.client.GetLanInterfaceHost(ctx, model.Interface.ValueString(), model.HostID.ValueString())
+	if err != nil {
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to get LAN host",
+			fmt.Sprintf("Failed to get LAN host %q at %q: %s", model.HostID.ValueString(), model.Interface.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(host)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *lanHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model lanHostModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, err := v.client.UpdateLanInterfaceHost(ctx, model.Interface.ValueString(), model.HostID.ValueString(), model.toPayload())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update LAN host",
+			fmt.Sprintf("Failed to update LAN host %q at %q: %s", model.HostID.ValueString(), model.Interface.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(host)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *lanHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model lanHostModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := v.client.DeleteLanInterfaceHost(ctx, model.Interface.ValueString(), model.HostID.ValueString()); err != nil {
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+			return // Already forgotten
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete LAN host",
+			fmt.Sprintf("Failed to delete LAN host %q at %q: %s", model.HostID.ValueString(), model.Interface.ValueString(), err),
+		)
+	}
+}
+
+func (v *lanHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	interfaceName, hostID, ok := strings.Cut(req.ID, "/")
+	if !ok || interfaceName == "" || hostID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected the import identifier to be in the form <interface>/<host_id> but got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), interfaceName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host_id"), hostID)...)
+}
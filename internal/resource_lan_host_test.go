@@ -0,0 +1,104 @@
+package internal_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_lan_host" { ... }`, func() {
+	var (
+		resourceName string
+		config       string
+		lanHost      freeboxTypes.LanInterfaceHost
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resourceName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		// Creating a static lease is the simplest way to make the LAN browser know about a host
+		var err error
+		lanHost, err = freeboxClient.CreateDHCPStaticLease(ctx, freeboxTypes.DHCPStaticLeasePayload{
+			Mac: fmt.Sprintf("02:00:%02X:%02X:%02X:%02X",
+				randGenerator.Intn(256), randGenerator.Intn(256),
+				randGenerator.Intn(256), randGenerator.Intn(256),
+			),
+			IP:       fmt.Sprintf("192.168.1.%d", randGenerator.Intn(54)+200),
+			Hostname: resourceName,
+		})
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			err := freeboxClient.DeleteDHCPStaticLease(ctx, lanHost.ID)
+			if err != nil {
+				var apiErr *client.APIError
+				if !errors.As(err, &apiErr) || apiErr.Code != "noent" {
+					Expect(err).To(BeNil(), "failed to clean up DHCP lease %s", lanHost.ID)
+				}
+			}
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_lan_host" "` + resourceName + `" {
+				interface    = "` + lanHost.Interface + `"
+				host_id      = "` + lanHost.ID + `"
+				primary_name = "` + resourceName + `"
+				host_type    = "workstation"
+				persistent   = true
+			}
+		`
+	})
+
+	It("should update, import and forget the host", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "interface", lanHost.Interface),
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "host_id", lanHost.ID),
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "primary_name", resourceName),
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "host_type", "workstation"),
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "persistent", "true"),
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "host.id", lanHost.ID),
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "host.primary_name_manual", "true"),
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("host_type", "nas")(terraformConfigWithAttribute("primary_name", resourceName+"-nas")(config)),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "primary_name", resourceName+"-nas"),
+						resource.TestCheckResourceAttr("freebox_lan_host."+resourceName, "host_type", "nas"),
+						func(s *terraform.State) error {
+							host, err := freeboxClient.GetLanInterfaceHost(ctx, lanHost.Interface, lanHost.ID)
+							Expect(err).To(BeNil())
+							Expect(host.PrimaryName).To(Equal(resourceName + "-nas"))
+							Expect(string(host.Type)).To(Equal("nas"))
+							return nil
+						},
+					),
+				},
+				{
+					ResourceName:                         "freebox_lan_host." + resourceName,
+					ImportState:                          true,
+					ImportStateId:                        lanHost.Interface + "/" + lanHost.ID,
+					ImportStateVerify:                    true,
+					ImportStateVerifyIdentifierAttribute: "host_id",
+					ImportStateVerifyIgnore:              []string{"host"},
+				},
+			},
+		})
+	})
+})
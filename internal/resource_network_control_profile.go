@@ -0,0 +1,758 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &networkControlProfileResource{}
+	_ resource.ResourceWithImportState    = &networkControlProfileResource{}
+	_ resource.ResourceWithValidateConfig = &networkControlProfileResource{}
+)
+
+func NewNetworkControlProfileResource() resource.Resource {
+	return &networkControlProfileResource{}
+}
+
+// networkControlProfileResource defines the resource implementation.
+type networkControlProfileResource struct {
+	client client.Client
+}
+
+// networkControlProfileModel describes the resource data model.
+type networkControlProfileModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	RuleMode      types.String `tfsdk:"rule_mode"`
+	Macs          types.Set    `tfsdk:"macs"`
+	Schedule      types.List   `tfsdk:"schedule"`
+	Override      types.Object `tfsdk:"override"`
+	HostOverrides types.Map    `tfsdk:"host_overrides"`
+}
+
+type networkControlOverrideModel struct {
+	Mode  types.String      `tfsdk:"mode"`
+	Until timetypes.RFC3339 `tfsdk:"until"`
+}
+
+func (o networkControlOverrideModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"mode":  types.StringType,
+		"until": timetypes.RFC3339Type{},
+	}
+}
+
+type networkControlHostOverrideModel struct {
+	ProfileID types.Int64       `tfsdk:"profile_id"`
+	Mode      types.String      `tfsdk:"mode"`
+	Until     timetypes.RFC3339 `tfsdk:"until"`
+}
+
+func (o networkControlHostOverrideModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"profile_id": types.Int64Type,
+		"mode":       types.StringType,
+		"until":      timetypes.RFC3339Type{},
+	}
+}
+
+// setNetworkControlOverride enables the override of the payload, unless mode is not set.
+func setNetworkControlOverride(payload *freeboxTypes.NetworkControl, mode types.String, until timetypes.RFC3339) (diagnostics diag.Diagnostics) {
+	if mode.IsNull() || mode.IsUnknown() {
+		return nil
+	}
+
+	payload.Override = true
+	payload.OverrideMode = mode.ValueString()
+
+	if !until.IsNull() && !until.IsUnknown() {
+		value, diags := until.ValueRFC3339Time()
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+		payload.OverrideUntil = value.Unix()
+	}
+
+	return nil
+}
+
+// networkControlOverrideFrom returns the mode and the end of the override of the network control,
+// both null when it is not overridden.
+func networkControlOverrideFrom(networkControl freeboxTypes.NetworkControl) (types.String, timetypes.RFC3339) {
+	if !networkControl.Override {
+		return basetypes.NewStringNull(), timetypes.NewRFC3339Null()
+	}
+
+	until := timetypes.NewRFC3339Null()
+	if networkControl.OverrideUntil != 0 {
+		until = timetypes.NewRFC3339TimeValue(time.Unix(networkControl.OverrideUntil, 0).UTC())
+	}
+
+	return basetypes.NewStringValue(networkControl.OverrideMode), until
+}
+
+func (m *networkControlProfileModel) toNetworkControlPayload(ctx context.Context) (payload freeboxTypes.NetworkControl, diagnostics diag.Diagnostics) {
+	payload.RuleMode = m.RuleMode.ValueString()
+	payload.Macs = make([]string, 0)
+
+	if !m.Macs.IsNull() && !m.Macs.IsUnknown() {
+		if diags := m.Macs.ElementsAs(ctx, &payload.Macs, false); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+		slices.Sort(payload.Macs)
+	}
+
+	if m.Override.IsNull() || m.Override.IsUnknown() {
+		return payload, nil
+	}
+
+	var override networkControlOverrideModel
+	if diags := m.Override.As(ctx, &override, basetypes.ObjectAsOptions{}); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	if diags := setNetworkControlOverride(&payload, override.Mode, override.Until); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	return payload, nil
+}
+
+// hostOverrides returns the host overrides of the model, keyed by MAC address.
+func (m *networkControlProfileModel) hostOverrides(ctx context.Context) (overrides map[string]networkControlHostOverrideModel, diagnostics diag.Diagnostics) {
+	overrides = make(map[string]networkControlHostOverrideModel)
+
+	if m.HostOverrides.IsNull() || m.HostOverrides.IsUnknown() {
+		return overrides, nil
+	}
+
+	diagnostics.Append(m.HostOverrides.ElementsAs(ctx, &overrides, false)...)
+
+	return overrides, diagnostics
+}
+
+func (m *networkControlProfileModel) setHostOverrides(ctx context.Context, overrides map[string]networkControlHostOverrideModel) (diagnostics diag.Diagnostics) {
+	// Keep the attribute unset if it was not set in the first place
+	if len(overrides) == 0 && m.HostOverrides.IsNull() {
+		return nil
+	}
+
+	m.HostOverrides, diagnostics = basetypes.NewMapValueFrom(ctx, types.ObjectType{}.WithAttributeTypes(networkControlHostOverrideModel{}.AttrTypes()), overrides)
+
+	return diagnostics
+}
+
+func (m *networkControlProfileModel) fromClientType(profile freeboxTypes.NetworkControlProfile) {
+	m.ID = basetypes.NewInt64Value(profile.ID)
+	m.Name = basetypes.NewStringValue(profile.Name)
+}
+
+func (m *networkControlProfileModel) fromNetworkControl(networkControl freeboxTypes.NetworkControl) {
+	m.RuleMode = basetypes.NewStringValue(networkControl.RuleMode)
+
+	// Keep the attribute unset if it was not set in the first place
+	if len(networkControl.Macs) != 0 || !m.Macs.IsNull() {
+		macs := make([]attr.Value, len(networkControl.Macs))
+		for i, mac := range networkControl.Macs {
+			macs[i] = basetypes.NewStringValue(mac)
+		}
+		m.Macs = basetypes.NewSetValueMust(types.StringType, macs)
+	}
+
+	mode, until := networkControlOverrideFrom(networkControl)
+	if mode.IsNull() {
+		m.Override = basetypes.NewObjectNull(networkControlOverrideModel{}.AttrTypes())
+		return
+	}
+
+	m.Override = basetypes.NewObjectValueMust(networkControlOverrideModel{}.AttrTypes(), map[string]attr.Value{
+		"mode":  mode,
+		"until": until,
+	})
+}
+
+// fromPlanning sets the schedule from the planning of the Freebox. The current schedule is
+// kept as is when it is equivalent to the planning, so that the way it is written in the
+// configuration does not produce any drift.
+func (m *networkControlProfileModel) fromPlanning(ctx context.Context, planning freeboxTypes.NetworkControlPlanning) (diagnostics diag.Diagnostics) {
+	resolution := int(planning.Resolution)
+
+	if !m.Schedule.IsUnknown() {
+		current, diags := models.NetworkControlScheduleToMapping(ctx, m.Schedule, m.RuleMode.ValueString(), resolution)
+		if !diags.HasError() && slices.Equal(current, planning.Mapping) {
+			return nil
+		}
+	}
+
+	schedule, diags := models.NetworkControlScheduleFromMapping(planning.Mapping, m.RuleMode.ValueString(), resolution)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	m.Schedule = schedule
+
+	return nil
+}
+
+func (v *networkControlProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_control_profile"
+}
+
+func (v *networkControlProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a network control (parental control) profile: a set of hosts of the local network sharing the same weekly Internet access schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the profile",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the profile",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"rule_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Access mode applied outside of the time ranges of the `schedule` (`allowed` or `denied`)",
+				Default:             stringdefault.StaticString(models.NetworkControlModeAllowed),
+				Validators: []validator.String{
+					stringvalidator.OneOf(models.NetworkControlModeAllowed, models.NetworkControlModeDenied),
+				},
+			},
+			"macs": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "MAC addresses of the hosts attached to the profile",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`),
+							"Must be a valid MAC address",
+						),
+					),
+				},
+			},
+			"schedule": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Weekly schedule of the profile, as a list of time ranges. The ranges must be aligned on the slots of the Freebox planning (30 minutes on most models)",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: models.NetworkControlScheduleRangeModel{}.ResourceAttributes(),
+				},
+			},
+			"override": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Temporary override of the schedule, applied to every host attached to the profile",
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Access mode to force (`allowed` or `denied`)",
+						Validators: []validator.String{
+							stringvalidator.OneOf(models.NetworkControlModeAllowed, models.NetworkControlModeDenied),
+						},
+					},
+					"until": schema.StringAttribute{
+						Optional:            true,
+						CustomType:          timetypes.RFC3339Type{},
+						MarkdownDescription: "End of the override as an RFC3339 timestamp. If not set, the override lasts until it is removed",
+					},
+				},
+			},
+			"host_overrides": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Temporary overrides of the schedule of single hosts attached to the profile, keyed by MAC address. As the Freebox only supports overrides of whole profiles, each overridden host is moved to a dedicated profile named after this one and its MAC address, which shares the rule mode and the schedule of this profile. The overrides are not imported with the profile",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`),
+							"Must be a valid MAC address",
+						),
+					),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"profile_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Unique identifier of the dedicated profile of the host",
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"mode": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Access mode to force for the host (`allowed` or `denied`)",
+							Validators: []validator.String{
+								stringvalidator.OneOf(models.NetworkControlModeAllowed, models.NetworkControlModeDenied),
+							},
+						},
+						"until": schema.StringAttribute{
+							Optional:            true,
+							CustomType:          timetypes.RFC3339Type{},
+							MarkdownDescription: "End of the override as an RFC3339 timestamp. If not set, the override lasts until it is removed",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (v *networkControlProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *networkControlProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data networkControlProfileModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.HostOverrides.IsNull() || data.HostOverrides.IsUnknown() || data.Macs.IsUnknown() {
+		return
+	}
+
+	macs := make([]string, 0, len(data.Macs.Elements()))
+	for _, element := range data.Macs.Elements() {
+		mac, ok := element.(types.String)
+		if !ok || mac.IsUnknown() {
+			return
+		}
+		macs = append(macs, mac.ValueString())
+	}
+
+	for mac := range data.HostOverrides.Elements() {
+		if !slices.Contains(macs, mac) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("host_overrides").AtMapKey(mac),
+				"Unknown host",
+				fmt.Sprintf("The host %s must be listed in macs to be overridden", mac),
+			)
+		}
+	}
+}
+
+func (v *networkControlProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model networkControlProfileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := v.client.CreateNetworkControlProfile(ctx, freeboxTypes.NetworkControlProfilePayload{
+		Name: model.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create network control profile",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(profile)
+
+	resp.Diagnostics.Append(v.apply(ctx, &model, nil)...)
+
+	// Saved even when failing, so that neither the profile nor the dedicated profiles of the overridden hosts are leaked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *networkControlProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model networkControlProfileModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := v.client.GetNetworkControlProfile(ctx, model.ID.ValueInt64())
+	if err != nil {
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to get network control profile",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(profile)
+
+	networkControl, err := v.client.GetNetworkControl(ctx, model.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get network control",
+			fmt.Sprintf("Profile: %d, Error: %s", model.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	overridden, diags := v.readHostOverrides(ctx, &model)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	networkControl.Macs = append(networkControl.Macs, overridden...)
+	model.fromNetworkControl(networkControl)
+
+	planning, err := v.client.GetNetworkControlPlanning(ctx, model.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get network control planning",
+			fmt.Sprintf("Profile: %d, Error: %s", model.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	if diags := model.fromPlanning(ctx, planning); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *networkControlProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state networkControlProfileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := state.hostOverrides(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	profile, err := v.client.UpdateNetworkControlProfile(ctx, model.ID.ValueInt64(), freeboxTypes.NetworkControlProfilePayload{
+		Name: model.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update network control profile",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(profile)
+
+	resp.Diagnostics.Append(v.apply(ctx, &model, previous)...)
+
+	// Saved even when failing, so that the dedicated profiles of the overridden hosts are not leaked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *networkControlProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model networkControlProfileModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	overrides, diags := model.hostOverrides(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	for mac, override := range overrides {
+		if override.ProfileID.IsNull() {
+			continue
+		}
+
+		if err := v.deleteProfile(ctx, override.ProfileID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to delete network control profile",
+				fmt.Sprintf("Host: %s, Profile: %d, Error: %s", mac, override.ProfileID.ValueInt64(), err),
+			)
+			return
+		}
+	}
+
+	if err := v.deleteProfile(ctx, model.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete network control profile",
+			err.Error(),
+		)
+	}
+}
+
+func (v *networkControlProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected the import identifier to be an int64 but got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// deleteProfile deletes a network control profile, ignoring the ones already deleted.
+func (v *networkControlProfileResource) deleteProfile(ctx context.Context, id int64) error {
+	err := v.client.DeleteNetworkControlProfile(ctx, id)
+
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+		return nil // Already deleted
+	}
+
+	return err
+}
+
+// apply pushes the network control settings and the planning of the profile to the Freebox.
+// The overridden hosts are moved to their dedicated profiles, and the dedicated profiles of
+// the previous overrides that were removed are deleted, which attaches their hosts back.
+func (v *networkControlProfileResource) apply(ctx context.Context, model *networkControlProfileModel, previous map[string]networkControlHostOverrideModel) (diagnostics diag.Diagnostics) {
+	overrides, diags := model.hostOverrides(ctx)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	for mac, override := range overrides {
+		if override.ProfileID.IsUnknown() {
+			override.ProfileID = basetypes.NewInt64Null() // Set once the dedicated profile is created
+			overrides[mac] = override
+		}
+	}
+
+	defer func() {
+		diagnostics.Append(model.setHostOverrides(ctx, overrides)...)
+	}()
+
+	for mac, override := range previous {
+		if _, ok := overrides[mac]; ok || override.ProfileID.IsNull() {
+			continue
+		}
+
+		if err := v.deleteProfile(ctx, override.ProfileID.ValueInt64()); err != nil {
+			overrides[mac] = override // Kept so that the deletion is attempted again
+			diagnostics.AddError("Failed to delete network control profile", fmt.Sprintf("Host: %s, Profile: %d, Error: %s", mac, override.ProfileID.ValueInt64(), err))
+			return
+		}
+	}
+
+	payload, diags := model.toNetworkControlPayload(ctx)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	overridden := slices.Sorted(maps.Keys(overrides))
+
+	payload.Macs = slices.DeleteFunc(payload.Macs, func(mac string) bool {
+		return slices.Contains(overridden, mac)
+	})
+
+	networkControl, err := v.client.UpdateNetworkControl(ctx, model.ID.ValueInt64(), payload)
+	if err != nil {
+		diagnostics.AddError("Failed to update network control", fmt.Sprintf("Profile: %d, Error: %s", model.ID.ValueInt64(), err))
+		return
+	}
+
+	planning, err := v.client.GetNetworkControlPlanning(ctx, model.ID.ValueInt64())
+	if err != nil {
+		diagnostics.AddError("Failed to get network control planning", fmt.Sprintf("Profile: %d, Error: %s", model.ID.ValueInt64(), err))
+		return
+	}
+
+	mapping, diags := models.NetworkControlScheduleToMapping(ctx, model.Schedule, model.RuleMode.ValueString(), int(planning.Resolution))
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	planning.Mapping = mapping
+
+	planning, err = v.client.UpdateNetworkControlPlanning(ctx, model.ID.ValueInt64(), planning)
+	if err != nil {
+		diagnostics.AddError("Failed to update network control planning", fmt.Sprintf("Profile: %d, Error: %s", model.ID.ValueInt64(), err))
+		return
+	}
+
+	for _, mac := range overridden {
+		override := overrides[mac]
+		diags := v.applyHostOverride(ctx, model, mac, &override)
+		overrides[mac] = override
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	networkControl.Macs = append(networkControl.Macs, overridden...)
+	model.fromNetworkControl(networkControl)
+
+	return model.fromPlanning(ctx, planning)
+}
+
+// applyHostOverride moves the host to its dedicated profile, creating it when needed, and
+// overrides the schedule of this profile. The dedicated profile otherwise follows the rule mode
+// and the schedule of the profile of the model, so that the host gets them back once the
+// override ends.
+func (v *networkControlProfileResource) applyHostOverride(ctx context.Context, model *networkControlProfileModel, mac string, override *networkControlHostOverrideModel) (diagnostics diag.Diagnostics) {
+	profilePayload := freeboxTypes.NetworkControlProfilePayload{
+		Name: fmt.Sprintf("%s (%s)", model.Name.ValueString(), mac),
+	}
+
+	if override.ProfileID.IsNull() {
+		profile, err := v.client.CreateNetworkControlProfile(ctx, profilePayload)
+		if err != nil {
+			diagnostics.AddError("Failed to create network control profile", fmt.Sprintf("Host: %s, Error: %s", mac, err))
+			return
+		}
+		override.ProfileID = basetypes.NewInt64Value(profile.ID)
+	} else if _, err := v.client.UpdateNetworkControlProfile(ctx, override.ProfileID.ValueInt64(), profilePayload); err != nil {
+		diagnostics.AddError("Failed to update network control profile", fmt.Sprintf("Host: %s, Profile: %d, Error: %s", mac, override.ProfileID.ValueInt64(), err))
+		return
+	}
+
+	profileID := override.ProfileID.ValueInt64()
+
+	payload := freeboxTypes.NetworkControl{
+		RuleMode: model.RuleMode.ValueString(),
+		Macs:     []string{mac},
+	}
+	if diags := setNetworkControlOverride(&payload, override.Mode, override.Until); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	networkControl, err := v.client.UpdateNetworkControl(ctx, profileID, payload)
+	if err != nil {
+		diagnostics.AddError("Failed to update network control", fmt.Sprintf("Host: %s, Profile: %d, Error: %s", mac, profileID, err))
+		return
+	}
+
+	planning, err := v.client.GetNetworkControlPlanning(ctx, profileID)
+	if err != nil {
+		diagnostics.AddError("Failed to get network control planning", fmt.Sprintf("Host: %s, Profile: %d, Error: %s", mac, profileID, err))
+		return
+	}
+
+	mapping, diags := models.NetworkControlScheduleToMapping(ctx, model.Schedule, model.RuleMode.ValueString(), int(planning.Resolution))
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	planning.Mapping = mapping
+
+	if _, err := v.client.UpdateNetworkControlPlanning(ctx, profileID, planning); err != nil {
+		diagnostics.AddError("Failed to update network control planning", fmt.Sprintf("Host: %s, Profile: %d, Error: %s", mac, profileID, err))
+		return
+	}
+
+	override.Mode, override.Until = networkControlOverrideFrom(networkControl)
+
+	return nil
+}
+
+// readHostOverrides refreshes the host overrides of the model from their dedicated profiles and
+// returns the MAC addresses of the hosts still attached to them. The overrides whose dedicated
+// profile no longer exists are dropped.
+func (v *networkControlProfileResource) readHostOverrides(ctx context.Context, model *networkControlProfileModel) (macs []string, diagnostics diag.Diagnostics) {
+	overrides, diags := model.hostOverrides(ctx)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	for mac, override := range overrides {
+		if override.ProfileID.IsNull() {
+			delete(overrides, mac)
+			continue
+		}
+
+		networkControl, err := v.client.GetNetworkControl(ctx, override.ProfileID.ValueInt64())
+		if err != nil {
+			var apiErr *client.APIError
+			if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+				delete(overrides, mac)
+				continue
+			}
+
+			diagnostics.AddError("Failed to get network control", fmt.Sprintf("Host: %s, Profile: %d, Error: %s", mac, override.ProfileID.ValueInt64(), err))
+			return
+		}
+
+		if slices.ContainsFunc(networkControl.Macs, func(m string) bool { return strings.EqualFold(m, mac) }) {
+			override.Mode, override.Until = networkControlOverrideFrom(networkControl)
+			macs = append(macs, mac)
+		} else {
+			// The host was moved out of its dedicated profile so the override does not apply anymore
+			override.Mode, override.Until = basetypes.NewStringNull(), timetypes.NewRFC3339Null()
+		}
+		overrides[mac] = override
+	}
+
+	diagnostics.Append(model.setHostOverrides(ctx, overrides)...)
+
+	return macs, diagnostics
+}
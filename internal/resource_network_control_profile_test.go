@@ -0,0 +1,198 @@
+package internal_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nikolalohinski/free-go/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_network_control_profile" { ... }`, func() {
+	var (
+		resName string
+		config  string
+	)
+
+	BeforeEach(func() {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_network_control_profile" "` + resName + `" {
+				name      = "` + resName + `"
+				rule_mode = "allowed"
+				schedule  = [
+					{
+						days  = ["monday", "tuesday"]
+						start = "21:30"
+						end   = "24:00"
+						mode  = "denied"
+					},
+				]
+			}
+		`
+	})
+
+	It("should create, update, import and delete a profile", func(ctx SpecContext) {
+		var profileID int64
+
+		overriddenConfig := strings.Replace(config, `rule_mode = "allowed"`, `rule_mode = "allowed"
+				override  = { mode = "denied" }`, 1)
+
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "name", resName),
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "rule_mode", "allowed"),
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "schedule.#", "1"),
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "schedule.0.start", "21:30"),
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "schedule.0.end", "24:00"),
+						resource.TestCheckResourceAttrWith("freebox_network_control_profile."+resName, "id", func(value string) error {
+							id, err := strconv.ParseInt(value, 10, 64)
+							Expect(err).To(BeNil())
+							profileID = id
+
+							profile, err := freeboxClient.GetNetworkControlProfile(ctx, id)
+							Expect(err).To(BeNil())
+							Expect(profile.Name).To(Equal(resName))
+
+							planning, err := freeboxClient.GetNetworkControlPlanning(ctx, id)
+							Expect(err).To(BeNil())
+							slotsPerHour := int(planning.Resolution) / 24
+							Expect(planning.Mapping[22*slotsPerHour]).To(Equal("denied"))                             // Monday 22:00
+							Expect(planning.Mapping[int(planning.Resolution)+22*slotsPerHour]).To(Equal("denied"))    // Tuesday 22:00
+							Expect(planning.Mapping[2*int(planning.Resolution)+22*slotsPerHour]).To(Equal("allowed")) // Wednesday 22:00
+							return nil
+						}),
+					),
+				},
+				{
+					Config: overriddenConfig,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "override.mode", "denied"),
+						func(s *terraform.State) error {
+							networkControl, err := freeboxClient.GetNetworkControl(ctx, profileID)
+							Expect(err).To(BeNil())
+							Expect(networkControl.Override).To(BeTrue())
+							Expect(networkControl.OverrideMode).To(Equal("denied"))
+							return nil
+						},
+					),
+				},
+				{
+					Config:            overriddenConfig,
+					ResourceName:      "freebox_network_control_profile." + resName,
+					ImportState:       true,
+					ImportStateIdFunc: func(s *terraform.State) (string, error) { return strconv.FormatInt(profileID, 10), nil },
+					ImportStateVerify: true,
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				_, err := freeboxClient.GetNetworkControlProfile(ctx, profileID)
+				var apiErr *client.APIError
+				Expect(errors.As(err, &apiErr)).To(BeTrue())
+				Expect(apiErr.Code).To(Equal("noent"))
+				return nil
+			},
+		})
+	})
+
+	It("should move an overridden host to a dedicated profile", func(ctx SpecContext) {
+		var profileID, hostProfileID int64
+
+		mac := fmt.Sprintf("02:00:00:00:%02X:%02X", randGenerator.Intn(256), randGenerator.Intn(256))
+
+		hostConfig := strings.Replace(config, `rule_mode = "allowed"`, `rule_mode = "allowed"
+				macs      = ["`+mac+`"]`, 1)
+		overriddenConfig := strings.Replace(hostConfig, `macs      = ["`+mac+`"]`, `macs      = ["`+mac+`"]
+				host_overrides = {
+					"`+mac+`" = { mode = "denied" }
+				}`, 1)
+
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: overriddenConfig,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "macs.#", "1"),
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "host_overrides."+mac+".mode", "denied"),
+						resource.TestCheckResourceAttrWith("freebox_network_control_profile."+resName, "id", func(value string) error {
+							id, err := strconv.ParseInt(value, 10, 64)
+							Expect(err).To(BeNil())
+							profileID = id
+
+							networkControl, err := freeboxClient.GetNetworkControl(ctx, id)
+							Expect(err).To(BeNil())
+							Expect(networkControl.Macs).To(BeEmpty())
+							Expect(networkControl.Override).To(BeFalse())
+							return nil
+						}),
+						resource.TestCheckResourceAttrWith("freebox_network_control_profile."+resName, "host_overrides."+mac+".profile_id", func(value string) error {
+							id, err := strconv.ParseInt(value, 10, 64)
+							Expect(err).To(BeNil())
+							hostProfileID = id
+
+							profile, err := freeboxClient.GetNetworkControlProfile(ctx, id)
+							Expect(err).To(BeNil())
+							Expect(profile.Name).To(Equal(resName + " (" + mac + ")"))
+
+							networkControl, err := freeboxClient.GetNetworkControl(ctx, id)
+							Expect(err).To(BeNil())
+							Expect(networkControl.Macs).To(HaveLen(1))
+							Expect(strings.ToUpper(networkControl.Macs[0])).To(Equal(mac))
+							Expect(networkControl.Override).To(BeTrue())
+							Expect(networkControl.OverrideMode).To(Equal("denied"))
+
+							planning, err := freeboxClient.GetNetworkControlPlanning(ctx, id)
+							Expect(err).To(BeNil())
+							slotsPerHour := int(planning.Resolution) / 24
+							Expect(planning.Mapping[22*slotsPerHour]).To(Equal("denied")) // Monday 22:00
+							return nil
+						}),
+					),
+				},
+				{
+					Config: hostConfig,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_network_control_profile."+resName, "macs.#", "1"),
+						resource.TestCheckNoResourceAttr("freebox_network_control_profile."+resName, "host_overrides"),
+						func(s *terraform.State) error {
+							_, err := freeboxClient.GetNetworkControlProfile(ctx, hostProfileID)
+							var apiErr *client.APIError
+							Expect(errors.As(err, &apiErr)).To(BeTrue())
+							Expect(apiErr.Code).To(Equal("noent"))
+
+							networkControl, err := freeboxClient.GetNetworkControl(ctx, profileID)
+							Expect(err).To(BeNil())
+							Expect(networkControl.Macs).To(HaveLen(1))
+							Expect(strings.ToUpper(networkControl.Macs[0])).To(Equal(mac))
+							return nil
+						},
+					),
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				for _, id := range []int64{profileID, hostProfileID} {
+					_, err := freeboxClient.GetNetworkControlProfile(ctx, id)
+					var apiErr *client.APIError
+					Expect(errors.As(err, &apiErr)).To(BeTrue())
+					Expect(apiErr.Code).To(Equal("noent"))
+				}
+				return nil
+			},
+		})
+	})
+})
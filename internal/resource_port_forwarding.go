@@ -24,6 +24,7 @@ import (
 var (
 	_ resource.ResourceWithImportState    = &portForwardingResource{}
 	_ resource.ResourceWithValidateConfig = &portForwardingResource{}
+	_ resource.ResourceWithModifyPlan     = &portForwardingResource{}
 )
 
 func NewPortForwardingResource() resource.Resource {
@@ -81,6 +82,16 @@ func (p *portForwardingModel) toPayload() freeboxTypes.PortForwardingRulePayload
 	return payload
 }
 
+// wanPortRange returns the inclusive WAN port range of the rule, using the same
+// defaulting logic as toPayload when the end of the range is not set.
+func (p *portForwardingModel) wanPortRange() (start, end int64) {
+	start = p.PortRangeStart.ValueInt64()
+	if p.PortRangeEnd.IsNull() || p.PortRangeEnd.IsUnknown() {
+		return start, start
+	}
+	return start, p.PortRangeEnd.ValueInt64()
+}
+
 func (p *portForwardingModel) fromClientType(rule freeboxTypes.PortForwardingRule) {
 	p.ID = basetypes.NewInt64Value(rule.ID)
 	if rule.Enabled != nil {
@@ -118,7 +129,7 @@ func (v *portForwardingResource) Metadata(ctx context.Context, req resource.Meta
 
 func (v *portForwardingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a port forwarding between a local network host and the Freebox Internet Gateway.\n\nNote: To have access to the full port range, you need to enable the Full Stack IP (see [the Freebox documentation (in French)](https://assistance.free.fr/articles/1758)).",
+		MarkdownDescription: "Manages a port forwarding between a local network host and the Freebox Internet Gateway.\n\nNote: To have access to the full port range, you need to enable the Full Stack IP (see [the Freebox documentation (in French)](https://assistance.free.fr/articles/1758)).\n\nNote: Overlapping WAN port ranges with existing rules of the same protocol, including rules created outside of Terraform, are reported at plan time.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Computed:            true,
@@ -211,6 +222,71 @@ func (v *portForwardingResource) ValidateConfig(ctx context.Context, req resourc
 	}
 }
 
+func (v *portForwardingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || v.client == nil {
+		return // Destroying or provider not configured yet
+	}
+
+	var plan portForwardingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IPProtocol.IsUnknown() || plan.PortRangeStart.IsUnknown() {
+		return // Can not be checked until the values are known
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state portForwardingModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		planStart, planEnd := plan.wanPortRange()
+		stateStart, stateEnd := state.wanPortRange()
+		if plan.IPProtocol.Equal(state.IPProtocol) && planStart == stateStart && planEnd == stateEnd {
+			return // The WAN side of the rule is unchanged
+		}
+	}
+
+	rules, err := v.client.ListPortForwardingRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list port forwarding rules",
+			err.Error(),
+		)
+		return
+	}
+
+	start, end := plan.wanPortRange()
+	for _, rule := range rules {
+		if !plan.ID.IsNull() && !plan.ID.IsUnknown() && rule.ID == plan.ID.ValueInt64() {
+			continue // Do not conflict with itself
+		}
+		if rule.IPProtocol != plan.IPProtocol.ValueString() {
+			continue
+		}
+		if rule.WanPortStart > end || rule.WanPortEnd < start {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("port_range_start"),
+			"Conflicting port forwarding rule",
+			fmt.Sprintf("The WAN port range %d-%d/%s overlaps with the existing rule %d (comment: %q) forwarding the WAN port range %d-%d/%s to %s:%d",
+				start, end, plan.IPProtocol.ValueString(),
+				rule.ID, rule.Comment,
+				rule.WanPortStart, rule.WanPortEnd, rule.IPProtocol,
+				rule.LanIP, rule.LanPort,
+			),
+		)
+	}
+}
+
 func (v *portForwardingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gstruct"
@@ -369,6 +370,68 @@ var _ = Describe("resource \"freebox_port_forwarding\" { ... }", func() {
 		})
 	})
 
+	Context("conflicting with an existing rule", func() {
+		var existingRule freeboxTypes.PortForwardingRule
+
+		BeforeEach(func(ctx SpecContext) {
+			existingEnabled := false
+			rule, err := freeboxClient.CreatePortForwardingRule(ctx, freeboxTypes.PortForwardingRulePayload{
+				Enabled:      &existingEnabled,
+				IPProtocol:   ipProtocol,
+				LanIP:        targetIP,
+				LanPort:      portRangeStart,
+				WanPortStart: portRangeStart,
+				WanPortEnd:   portRangeStart,
+				Comment:      resourceName + "-existing",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			existingRule = rule
+
+			DeferCleanup(func(ctx SpecContext) {
+				Expect(freeboxClient.DeletePortForwardingRule(ctx, existingRule.ID)).To(Succeed())
+			})
+		})
+
+		JustBeforeEach(func(ctx SpecContext) {
+			initialConfig = terraformConfigWithoutAttribute(`target_port`)(initialConfig)
+		})
+
+		It("should report the overlap at plan time", func(ctx SpecContext) {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						PlanOnly:    true,
+						Config:      initialConfig,
+						ExpectError: regexp.MustCompile(`(?s)Conflicting port forwarding rule.*` + strconv.FormatInt(existingRule.ID, 10) + `.*` + resourceName + `-existing`),
+					},
+				},
+			})
+		})
+
+		Describe("using the other protocol", func() {
+			JustBeforeEach(func(ctx SpecContext) {
+				initialConfig = terraformConfigWithAttribute(`ip_protocol`, "udp")(initialConfig)
+			})
+
+			It("should not report any conflict", func(ctx SpecContext) {
+				resource.UnitTest(GinkgoT(), resource.TestCase{
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: initialConfig,
+							Check: resource.ComposeAggregateTestCheckFunc(
+								resource.TestCheckResourceAttr("freebox_port_forwarding."+resourceName, "ip_protocol", "udp"),
+								resource.TestCheckResourceAttr("freebox_port_forwarding."+resourceName, "port_range_start", strconv.FormatInt(portRangeStart, 10)),
+							),
+						},
+					},
+				})
+			})
+		})
+	})
+
 	Context("schema validation", func() {
 		It("should reject setting host in config because it is read-only", func(ctx SpecContext) {
 			resource.UnitTest(GinkgoT(), resource.TestCase{
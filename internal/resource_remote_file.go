@@ -10,9 +10,11 @@ import (
 	"io"
 	"os"
 	go_path "path"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -36,6 +39,7 @@ import (
 var (
 	_ resource.Resource                = (*remoteFileResource)(nil)
 	_ resource.ResourceWithImportState = (*remoteFileResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*remoteFileResource)(nil)
 )
 
 func NewRemoteFileResource() resource.Resource {
@@ -77,6 +81,12 @@ type remoteFileModel struct {
 
 	// Parents is whether to create parent directories.
 	Parents types.Bool `tfsdk:"parents"`
+
+	// FreeSpaceMargin is the space in bytes to keep free on the destination partition.
+	FreeSpaceMargin types.Int64 `tfsdk:"free_space_margin"`
+
+	// ExtractedFiles are the paths created by the extraction of the file.
+	ExtractedFiles types.List `tfsdk:"extracted_files"`
 }
 
 func (o remoteFileModel) AttrTypes() map[string]attr.Type {
@@ -91,6 +101,8 @@ func (o remoteFileModel) AttrTypes() map[string]attr.Type {
 		"authentication":     types.ObjectType{}.WithAttributeTypes(remoteFileModelAuthenticationsModel{}.AttrTypes()),
 		"polling":            types.ObjectType{}.WithAttributeTypes(remoteFilePollingModel{}.AttrTypes()),
 		"parents":            types.BoolType,
+		"free_space_margin":  types.Int64Type,
+		"extracted_files":    types.ListType{ElemType: types.StringType},
 	}
 }
 
@@ -98,6 +110,7 @@ type remoteFileExtractModel struct {
 	DestinationPath types.String `tfsdk:"destination_path"`
 	Password        types.String `tfsdk:"password"`
 	Overwrite       types.Bool   `tfsdk:"overwrite"`
+	RemoveOnDestroy types.Bool   `tfsdk:"remove_on_destroy"`
 }
 
 func (o remoteFileExtractModel) ResourceAttributes() map[string]schema.Attribute {
@@ -115,22 +128,28 @@ func (o remoteFileExtractModel) ResourceAttributes() map[string]schema.Attribute
 			Optional:            true,
 			MarkdownDescription: "Overwrite files on conflict",
 		},
+		"remove_on_destroy": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "Remove the extracted files when the file is destroyed or replaced",
+		},
 	}
 }
 
 func (o remoteFileExtractModel) AttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"destination_path": types.StringType,
-		"password":         types.StringType,
-		"overwrite":        types.BoolType,
+		"destination_path":  types.StringType,
+		"password":          types.StringType,
+		"overwrite":         types.BoolType,
+		"remove_on_destroy": types.BoolType,
 	}
 }
 
 func (o remoteFileExtractModel) defaults() basetypes.ObjectValue {
 	return basetypes.NewObjectValueMust(remoteFileExtractModel{}.AttrTypes(), map[string]attr.Value{
-		"destination_path": basetypes.NewStringNull(),
-		"password":         basetypes.NewStringValue(""),
-		"overwrite":        basetypes.NewBoolValue(false),
+		"destination_path":  basetypes.NewStringNull(),
+		"password":          basetypes.NewStringValue(""),
+		"overwrite":         basetypes.NewBoolValue(false),
+		"remove_on_destroy": basetypes.NewBoolValue(false),
 	})
 }
 
@@ -280,6 +299,9 @@ func (v *remoteFileModel) populateDefaults(ctx context.Context) (diagnostics dia
 	if v.Extract.IsUnknown() || v.Extract.IsNull() {
 		v.Extract = remoteFileExtractModel{}.defaults()
 	}
+	if v.ExtractedFiles.IsUnknown() || v.ExtractedFiles.IsNull() {
+		v.ExtractedFiles = basetypes.NewListValueMust(types.StringType, []attr.Value{})
+	}
 	if v.Authentication.IsUnknown() {
 		v.Authentication = remoteFileModelAuthenticationsModel{}.defaults()
 	}
@@ -546,6 +568,21 @@ func (v *remoteFileResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"extracted_files": schema.ListAttribute{
+				MarkdownDescription: "Paths of the entries created by the extraction at the top level of its destination, along with their content. Entries that existed before the extraction are not tracked. The file is extracted again when one of them goes missing",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"free_space_margin": schema.Int64Attribute{
+				MarkdownDescription: "Space in bytes to keep free on the destination partition. The plan fails early when the new or replacing file and this margin do not fit (default: `0`)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -567,6 +604,110 @@ func (v *remoteFileResource) Configure(ctx context.Context, req resource.Configu
 	v.client = client
 }
 
+func (v *remoteFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || v.client == nil {
+		return // Destroying or provider not configured yet
+	}
+
+	var plan remoteFileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if plan.DestinationPath.IsUnknown() || plan.FreeSpaceMargin.IsUnknown() {
+			return // Can not be checked until the values are known
+		}
+
+		resp.Diagnostics.Append(v.checkFreeSpace(ctx, &plan, 0)...)
+		return
+	}
+
+	var state remoteFileModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	drift, diags := providerdata.GetExtractionDrift(ctx, req.Private)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if drift {
+		// The file is extracted again by the update, see Update
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extracted_files"), basetypes.NewListUnknown(types.StringType))...)
+	}
+
+	if plan.DestinationPath.IsUnknown() || plan.FreeSpaceMargin.IsUnknown() || !plan.rewritten(&state) {
+		return // Can not be checked until the values are known, or the file is kept
+	}
+
+	// The previous file is deleted before the new one is written at the same path
+	var freed int64
+	if plan.DestinationPath.Equal(state.DestinationPath) {
+		if fileInfo, err := v.client.GetFileInfo(ctx, state.DestinationPath.ValueString()); err == nil {
+			freed = int64(fileInfo.SizeBytes)
+		}
+	}
+
+	resp.Diagnostics.Append(v.checkFreeSpace(ctx, &plan, freed)...)
+}
+
+// rewritten reports whether applying the plan writes the file again, either through a replacement or
+// a recreation. An unknown checksum means the file is replaced, see the plan modifiers of the checksum.
+func (m *remoteFileModel) rewritten(state *remoteFileModel) bool {
+	return !m.SourceURL.Equal(state.SourceURL) ||
+		!m.SourceRemoteFile.Equal(state.SourceRemoteFile) ||
+		!m.SourceContent.Equal(state.SourceContent) ||
+		!m.SourceLocalFile.Equal(state.SourceLocalFile) ||
+		m.Checksum.IsUnknown() ||
+		!m.Checksum.Equal(state.Checksum)
+}
+
+// checkFreeSpace verifies the destination partition can hold the file from the configured source,
+// once the given space of a replaced file is freed.
+func (v *remoteFileResource) checkFreeSpace(ctx context.Context, model *remoteFileModel, freed int64) diag.Diagnostics {
+	expected := v.expectedSize(ctx, model)
+	if expected > 0 {
+		expected = max(expected-freed, 0)
+	}
+
+	return checkFreeSpace(ctx, v.client, model.DestinationPath.ValueString(), expected, model.FreeSpaceMargin.ValueInt64())
+}
+
+// expectedSize returns the size in bytes of the file to create, or -1 when it can not be known in advance.
+func (v *remoteFileResource) expectedSize(ctx context.Context, model *remoteFileModel) int64 {
+	switch {
+	case !model.SourceContent.IsNull() && !model.SourceContent.IsUnknown():
+		return int64(len(model.SourceContent.ValueString()))
+	case !model.SourceLocalFile.IsNull() && !model.SourceLocalFile.IsUnknown():
+		fileInfo, err := os.Stat(model.SourceLocalFile.ValueString())
+		if err != nil {
+			return -1 // Reported when uploading the file
+		}
+		return fileInfo.Size()
+	case !model.SourceRemoteFile.IsNull() && !model.SourceRemoteFile.IsUnknown():
+		fileInfo, err := v.client.GetFileInfo(ctx, model.SourceRemoteFile.ValueString())
+		if err != nil {
+			return -1 // Reported when copying the file
+		}
+		return int64(fileInfo.SizeBytes)
+	case !model.SourceURL.IsNull() && !model.SourceURL.IsUnknown():
+		payload, diags := model.toDownloadPayload()
+		if diags.HasError() {
+			return -1
+		}
+		return contentLength(ctx, payload.DownloadURLs[0], payload.Username, payload.Password)
+	default:
+		return -1
+	}
+}
+
 func (v *remoteFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model remoteFileModel
 
@@ -579,6 +720,8 @@ func (v *remoteFileResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	model.ExtractedFiles = basetypes.NewListValueMust(types.StringType, []attr.Value{})
+
 	tflog.Debug(ctx, "Checking if the file already exists...")
 
 	if _, err := v.client.GetFileInfo(ctx, model.DestinationPath.ValueString()); err == nil {
@@ -626,11 +769,20 @@ func (v *remoteFileResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if diags := v.extract(ctx, resp.Private, &model, nil); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// extract extracts the file when configured, the previously tracked paths that still exist are kept
+// in the extracted files.
+func (v *remoteFileResource) extract(ctx context.Context, state providerdata.Setter, model *remoteFileModel, previous []string) (diagnostics diag.Diagnostics) {
 	if !model.Extract.IsNull() {
 		var extract *remoteFileExtractModel
 
 		if diags := model.Extract.As(ctx, &extract, basetypes.ObjectAsOptions{}); diags.HasError() {
-			resp.Diagnostics.Append(diags...)
+			diagnostics.Append(diags...)
 			return
 		}
 
@@ -641,6 +793,12 @@ func (v *remoteFileResource) Create(ctx context.Context, req resource.CreateRequ
 			dst := extract.DestinationPath.ValueString()
 			tflog.Debug(ctx, fmt.Sprintf("Extracting the file to %s", dst))
 
+			existing, diags := v.listExtractionDestination(ctx, dst)
+			if diags.HasError() {
+				diagnostics.Append(diags...)
+				return
+			}
+
 			task, err := v.client.ExtractFile(ctx, freeboxTypes.ExtractFilePayload{
 				Src:       freeboxTypes.Base64Path(src),
 				Dst:       freeboxTypes.Base64Path(dst),
@@ -648,7 +806,7 @@ func (v *remoteFileResource) Create(ctx context.Context, req resource.CreateRequ
 				Overwrite: extract.Overwrite.ValueBool(),
 			})
 			if err != nil {
-				resp.Diagnostics.AddError("Failed to extract file", err.Error())
+				diagnostics.AddError("Failed to extract file", err.Error())
 				return
 			}
 
@@ -656,27 +814,27 @@ func (v *remoteFileResource) Create(ctx context.Context, req resource.CreateRequ
 				"task.id": task.ID,
 			})
 
-			if diags := providerdata.SetCurrentTask(ctx, resp.Private, models.TaskTypeFileSystem, task.ID); diags.HasError() {
-				resp.Diagnostics.Append(diags...)
+			if diags := providerdata.SetCurrentTask(ctx, state, models.TaskTypeFileSystem, task.ID); diags.HasError() {
+				diagnostics.Append(diags...)
 				return
 			}
 
 			var polling remoteFilePollingModel
 
 			if diags := model.Polling.As(ctx, &polling, basetypes.ObjectAsOptions{}); diags.HasError() {
-				resp.Diagnostics.Append(diags...)
+				diagnostics.Append(diags...)
 				return
 			}
 
 			var extractPolling models.Polling
 
 			if diags := polling.Extract.As(ctx, &extractPolling, basetypes.ObjectAsOptions{}); diags.HasError() {
-				resp.Diagnostics.Append(diags...)
+				diagnostics.Append(diags...)
 				return
 			}
 
 			if diags := waitForFileSystemTask(ctx, v.client, task.ID, extractPolling); diags.HasError() {
-				resp.Diagnostics.Append(diags...)
+				diagnostics.Append(diags...)
 				return
 			}
 
@@ -685,26 +843,146 @@ func (v *remoteFileResource) Create(ctx context.Context, req resource.CreateRequ
 			})
 
 			if err := stopAndDeleteFileSystemTask(ctx, v.client, task.ID); err != nil {
-				resp.Diagnostics.AddError("Failed to stop and delete file system task", fmt.Sprintf("Task %d, Error: %s", task.ID, err.Error()))
+				diagnostics.AddError("Failed to stop and delete file system task", fmt.Sprintf("Task %d, Error: %s", task.ID, err.Error()))
 				return
 			}
 
-			if diags := providerdata.UnsetCurrentTask(ctx, resp.Private); diags.HasError() {
-				resp.Diagnostics.Append(diags...)
+			if diags := providerdata.UnsetCurrentTask(ctx, state); diags.HasError() {
+				diagnostics.Append(diags...)
+				return
+			}
+
+			extracted, diags := v.extractedPaths(ctx, dst, existing)
+			if diags.HasError() {
+				diagnostics.Append(diags...)
+				return
+			}
+
+			if diags := v.setExtractedFiles(ctx, model, extracted, previous); diags.HasError() {
+				diagnostics.Append(diags...)
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// listExtractionDestination lists the top-level entries of the extraction destination, nil when it does not exist yet.
+func (v *remoteFileResource) listExtractionDestination(ctx context.Context, destination string) (entries map[string]freeboxTypes.FileInfo, diagnostics diag.Diagnostics) {
+	files, err := listFiles(ctx, v.client, destination, 1, 1)
+	if err != nil {
+		if errors.Is(err, client.ErrPathNotFound) {
+			return // Created by the extraction
+		}
+		diagnostics.AddError("Failed to list extraction destination", fmt.Sprintf("Path: %s, Error: %s", destination, err.Error()))
+		return
+	}
+
+	entries = make(map[string]freeboxTypes.FileInfo, len(files))
+	for _, file := range files {
+		entries[string(file.Path)] = file
+	}
+
+	return
+}
+
+// extractedPaths lists the paths created by the extraction from the top-level entries of the destination before it.
+// Only the new top-level entries are walked, the rest of the destination can be arbitrarily large.
+func (v *remoteFileResource) extractedPaths(ctx context.Context, destination string, before map[string]freeboxTypes.FileInfo) (paths []string, diagnostics diag.Diagnostics) {
+	after, diags := v.listExtractionDestination(ctx, destination)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	if before == nil && after != nil {
+		paths = append(paths, go_path.Clean(destination))
+	}
+
+	for filePath, file := range after {
+		if _, ok := before[filePath]; ok {
+			continue
+		}
+
+		paths = append(paths, filePath)
+
+		if file.Type != freeboxTypes.FileTypeDirectory {
+			continue
+		}
+
+		children, err := listFiles(ctx, v.client, filePath, 1, 0)
+		if err != nil {
+			diagnostics.AddError("Failed to list extracted files", fmt.Sprintf("Path: %s, Error: %s", filePath, err.Error()))
+			return
+		}
+
+		for _, child := range children {
+			paths = append(paths, string(child.Path))
+		}
+	}
+
+	return
+}
+
+// setExtractedFiles records the paths created by the extraction along with the previously tracked paths that still exist,
+// sorted so that parents come first.
+func (v *remoteFileResource) setExtractedFiles(ctx context.Context, model *remoteFileModel, extracted, previous []string) (diagnostics diag.Diagnostics) {
+	tracked := make(map[string]struct{}, len(extracted)+len(previous))
+	for _, filePath := range extracted {
+		tracked[filePath] = struct{}{}
+	}
+
+	for _, filePath := range previous {
+		if _, ok := tracked[filePath]; ok {
+			continue
+		}
+
+		if _, err := v.client.GetFileInfo(ctx, filePath); err != nil {
+			if !errors.Is(err, client.ErrPathNotFound) {
+				diagnostics.AddError("Failed to get extracted file", fmt.Sprintf("Path: %s, Error: %s", filePath, err.Error()))
 				return
 			}
+			continue // Not restored by the extraction
 		}
+
+		tracked[filePath] = struct{}{}
+	}
+
+	paths := make([]string, 0, len(tracked))
+	for filePath := range tracked {
+		paths = append(paths, filePath)
 	}
+
+	sort.Strings(paths)
+
+	model.ExtractedFiles, diagnostics = basetypes.NewListValueFrom(ctx, types.StringType, paths)
+	return
+}
+
+// extractedFiles returns the tracked extracted paths.
+func (v *remoteFileModel) extractedFiles(ctx context.Context) (paths []string, diagnostics diag.Diagnostics) {
+	if v.ExtractedFiles.IsNull() || v.ExtractedFiles.IsUnknown() {
+		return
+	}
+
+	diagnostics.Append(v.ExtractedFiles.ElementsAs(ctx, &paths, false)...)
+	return
 }
 
 func (v *remoteFileResource) create(ctx context.Context, state providerdata.Setter, model *remoteFileModel) (diagnostics diag.Diagnostics) {
 	if model.Parents.ValueBool() {
-		if diags := v.createParentDirectories(ctx, model); diags.HasError() {
+		if diags := createDirectories(ctx, v.client, go_path.Dir(model.DestinationPath.ValueString())); diags.HasError() {
 			diagnostics.Append(diags...)
 			return
 		}
 	}
 
+	if diags := v.checkFreeSpace(ctx, model, 0); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
 	switch {
 	case !model.SourceURL.IsNull():
 		return v.createFromURL(ctx, state, model)
@@ -720,33 +998,6 @@ func (v *remoteFileResource) create(ctx context.Context, state providerdata.Sett
 	}
 }
 
-func (v *remoteFileResource) createParentDirectories(ctx context.Context, model *remoteFileModel) (diagnostics diag.Diagnostics) {
-	var parent string = "/"
-	for _, path := range strings.Split(strings.TrimPrefix(go_path.Dir(model.DestinationPath.ValueString()), "/"), "/") {
-		newParent, err := v.client.CreateDirectory(ctx, parent, path)
-		if err != nil {
-			if errors.Is(err, client.ErrDestinationConflict) {
-				if fileInfo, err := v.client.GetFileInfo(ctx, go_path.Join(parent, path)); err != nil {
-					diagnostics.AddWarning("Failed to inspect file", fmt.Sprintf("Path: %s, Error: %s", go_path.Join(parent, path), err.Error()))
-					return
-				} else if fileInfo.Type == freeboxTypes.FileTypeDirectory {
-					parent = go_path.Join(parent, path)
-					continue
-				}
-
-				diagnostics.AddError("Failed to create parent directories", fmt.Sprintf("Path: %s, Error: %s", go_path.Join(parent, path), err.Error()))
-				return
-			}
-
-			diagnostics.AddError("Failed to create directory", fmt.Sprintf("Path: %s, Error: %s", go_path.Join(parent, path), err.Error()))
-			return
-		}
-
-		parent = newParent
-	}
-	return
-}
-
 func (v *remoteFileResource) createFromURL(ctx context.Context, state providerdata.Setter, model *remoteFileModel) (diagnostics diag.Diagnostics) {
 	payload, diags := model.toDownloadPayload()
 	if diags.HasError() {
@@ -1132,6 +1383,39 @@ func (v *remoteFileResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	model.setChecksum(hMethod, hash)
+
+	if diags := v.verifyExtractedFiles(ctx, resp.Private, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// verifyExtractedFiles flags the files for extraction when some of the extracted files went missing.
+func (v *remoteFileResource) verifyExtractedFiles(ctx context.Context, state providerdata.Setter, model *remoteFileModel) (diagnostics diag.Diagnostics) {
+	paths, diags := model.extractedFiles(ctx)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	var missing []string
+	for _, filePath := range paths {
+		if _, err := v.client.GetFileInfo(ctx, filePath); err != nil {
+			if !errors.Is(err, client.ErrPathNotFound) {
+				diagnostics.AddError("Failed to get extracted file", fmt.Sprintf("Path: %s, Error: %s", filePath, err.Error()))
+				return
+			}
+			missing = append(missing, filePath)
+		}
+	}
+
+	if len(missing) > 0 {
+		tflog.Warn(ctx, "Some extracted files are missing, the file will be extracted again", map[string]interface{}{
+			"missing": missing,
+		})
+	}
+
+	return providerdata.SetExtractionDrift(ctx, state, len(missing) > 0)
 }
 
 func (v *remoteFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1144,6 +1428,9 @@ func (v *remoteFileResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Planned as unknown when some of the extracted files went missing, before the defaults replace it
+	extractAgain := newModel.ExtractedFiles.IsUnknown()
+
 	if diags := newModel.populateDefaults(ctx); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -1266,11 +1553,7 @@ func (v *remoteFileResource) Update(ctx context.Context, req resource.UpdateRequ
 			resp.Diagnostics.AddError("Checksum mismatch", fmt.Sprintf("Expected checksum %q, got %q, Path: %s", expected, result, newModel.DestinationPath.ValueString()))
 			return
 		}
-
-		return
-	}
-
-	if oldModel.DestinationPath.ValueString() != newModel.DestinationPath.ValueString() {
+	} else if oldModel.DestinationPath.ValueString() != newModel.DestinationPath.ValueString() {
 		tflog.Info(ctx, "Moving the file...")
 
 		tflog.Debug(ctx, "Checking if the file already exists...")
@@ -1317,8 +1600,29 @@ func (v *remoteFileResource) Update(ctx context.Context, req resource.UpdateRequ
 			resp.Diagnostics.Append(diags...)
 			return
 		}
+	}
 
-		return
+	if extractAgain {
+		tflog.Info(ctx, "Extracting the file again...")
+
+		previous, diags := oldModel.extractedFiles(ctx)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		// Kept as is when the extraction fails
+		newModel.ExtractedFiles = oldModel.ExtractedFiles
+
+		if diags := v.extract(ctx, resp.Private, &newModel, previous); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		if diags := providerdata.SetExtractionDrift(ctx, resp.Private, false); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
 	}
 }
 
@@ -1441,6 +1745,60 @@ func (v *remoteFileResource) Delete(ctx context.Context, req resource.DeleteRequ
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+
+	var extract remoteFileExtractModel
+
+	if diags := model.Extract.As(ctx, &extract, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true}); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if !extract.RemoveOnDestroy.ValueBool() {
+		return
+	}
+
+	extracted, diags := model.extractedFiles(ctx)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if len(extracted) == 0 {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting the extracted files...", map[string]interface{}{
+		"count": len(extracted),
+	})
+
+	if diags := deleteFilesIfExist(ctx, resp.Private, v.client, deletePolling, topLevelPaths(extracted)...); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+}
+
+// topLevelPaths drops the paths contained in another path of the list, removing a directory removes its content.
+func topLevelPaths(paths []string) []string {
+	kept := make(map[string]struct{}, len(paths))
+	for _, filePath := range paths {
+		kept[filePath] = struct{}{}
+	}
+
+	result := make([]string, 0, len(paths))
+	for _, filePath := range paths {
+		contained := false
+		for child, parent := filePath, go_path.Dir(filePath); parent != child; child, parent = parent, go_path.Dir(parent) {
+			if _, ok := kept[parent]; ok {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			result = append(result, filePath)
+		}
+	}
+
+	return result
 }
 
 func (v *remoteFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -1534,28 +1892,6 @@ func (v *remoteFileModel) fileChecksum(ctx context.Context, state providerdata.S
 		return
 	}
 
-	if hashType == "" {
-		hashType = string(freeboxTypes.HashTypeSHA256)
-	}
-
-	task, err := client.AddHashFileTask(ctx, freeboxTypes.HashPayload{
-		HashType: freeboxTypes.HashType(hashType),
-		Path:     freeboxTypes.Base64Path(path),
-	})
-	if err != nil {
-		diagnostics.AddError("Failed to request file hash", fmt.Sprintf("Path: %s, Hash type: %s, Error: %s", path, hashType, err.Error()))
-		return
-	}
-
-	ctx = tflog.SetField(ctx, "task.id", task.ID)
-
-	tflog.Debug(ctx, "Hasing the file...")
-
-	if diags := providerdata.SetCurrentTask(ctx, state, models.TaskTypeFileSystem, task.ID); diags.HasError() {
-		diagnostics.Append(diags...)
-		return
-	}
-
 	var checksumPolling models.Polling
 
 	if diags := polling.ChecksumCompute.As(ctx, &checksumPolling, basetypes.ObjectAsOptions{}); diags.HasError() {
@@ -1563,28 +1899,5 @@ func (v *remoteFileModel) fileChecksum(ctx context.Context, state providerdata.S
 		return
 	}
 
-	if diags := waitForFileSystemTask(ctx, client, task.ID, checksumPolling); diags.HasError() {
-		diagnostics.Append(diags...)
-		return
-	}
-
-	tflog.Debug(ctx, "Getting the hash result...")
-
-	hash, err := client.GetHashResult(ctx, task.ID)
-	if err != nil {
-		diagnostics.AddError("Failed to get hash result", fmt.Sprintf("Task %d, Path: %s, Hash type: %s, Error: %s", task.ID, path, hashType, err.Error()))
-		return
-	}
-
-	if err := stopAndDeleteFileSystemTask(ctx, client, task.ID); err != nil {
-		diagnostics.AddError("Failed to stop and delete file system task", fmt.Sprintf("Task %d, Error: %s", task.ID, err.Error()))
-		return
-	}
-
-	if diags := providerdata.UnsetCurrentTask(ctx, state); diags.HasError() {
-		diagnostics.Append(diags...)
-		return
-	}
-
-	return hash, nil
+	return hashFile(ctx, state, client, path, hashType, checksumPolling)
 }
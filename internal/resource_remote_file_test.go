@@ -1,6 +1,7 @@
 package internal_test
 
 import (
+	"math"
 	"path"
 	"regexp"
 	"strings"
@@ -64,6 +65,7 @@ var _ = Context(`resource "freebox_remote_file" { ... }`, func() {
 				}
 
 				extract = null
+				free_space_margin = null
 			}
 		`
 	})
@@ -295,6 +297,68 @@ var _ = Context(`resource "freebox_remote_file" { ... }`, func() {
 				})
 			})
 		})
+
+		Context("when the partition does not have enough free space", func() {
+			var contentConfig string
+
+			JustBeforeEach(func(ctx SpecContext) {
+				contentConfig = terraformConfigWithAttribute("source_url", nil)(initialConfig)
+				contentConfig = terraformConfigWithAttribute("source_content", "data")(contentConfig)
+				// $ echo -n data | sha256sum
+				contentConfig = terraformConfigWithAttribute("checksum", "sha256:3a6eb0790f39ac87c94f3856b2dd2c5d110e6811602261a9a923d3bb23adc8b7")(contentConfig)
+				// The largest margin can not fit on any partition, nor overflow once added to the size of the file
+				contentConfig = terraformConfigWithAttribute("free_space_margin", int64(math.MaxInt64))(contentConfig)
+			})
+
+			It("should fail before creating the file", func(ctx SpecContext) {
+				resource.UnitTest(GinkgoT(), resource.TestCase{
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config:      contentConfig,
+							ExpectError: regexp.MustCompile(`Not\s+enough\s+free\s+space`),
+						},
+					},
+					CheckDestroy: func(s *terraform.State) error {
+						_, err := freeboxClient.GetFileInfo(ctx, exampleFile.filepath)
+						Expect(err).To(MatchError(client.ErrPathNotFound), "file %s should not exist", exampleFile.filepath)
+
+						return nil
+					},
+				})
+			})
+
+			It("should fail before replacing the file", func(ctx SpecContext) {
+				resource.UnitTest(GinkgoT(), resource.TestCase{
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config: initialConfig,
+						},
+						{
+							Config:      contentConfig,
+							ExpectError: regexp.MustCompile(`Not\s+enough\s+free\s+space`),
+						},
+						{
+							Config: initialConfig,
+							Check: func(s *terraform.State) error {
+								fileInfo, err := freeboxClient.GetFileInfo(ctx, exampleFile.filepath)
+								Expect(err).To(BeNil(), "the downloaded file should have been kept")
+								Expect(fileInfo.Name).To(Equal(exampleFile.filename))
+
+								return nil
+							},
+						},
+					},
+					CheckDestroy: func(s *terraform.State) error {
+						_, err := freeboxClient.GetFileInfo(ctx, exampleFile.filepath)
+						Expect(err).To(MatchError(client.ErrPathNotFound), "file %s should not exist", exampleFile.filepath)
+
+						return nil
+					},
+				})
+			})
+		})
 	})
 	Context("create, update and delete", func() {
 		var newFile file
@@ -948,6 +1012,7 @@ var _ = Context(`resource "freebox_remote_file" { ... }`, func() {
 				initialConfig = terraformConfigWithAttribute("extract", []byte(`{
 					destination_path = "`+destinationPath+`"
 					overwrite = true
+					remove_on_destroy = true
 				}`))(initialConfig)
 			})
 
@@ -972,6 +1037,42 @@ var _ = Context(`resource "freebox_remote_file" { ... }`, func() {
 
 									return nil
 								},
+								resource.TestCheckResourceAttr("freebox_remote_file."+resourceName, "extracted_files.#", "1"),
+								func(s *terraform.State) error {
+									extractedFile := s.RootModule().Resources["freebox_remote_file."+resourceName].Primary.Attributes["extracted_files.0"]
+									Expect(extractedFile).To(HaveSuffix(extractedPath))
+
+									return nil
+								},
+							),
+						},
+						{
+							PreConfig: func() {
+								task, err := freeboxClient.RemoveFiles(ctx, []string{extractedPath})
+								Expect(err).To(BeNil())
+
+								Eventually(func() types.FileSystemTask {
+									task, err := freeboxClient.GetFileSystemTask(ctx, task.ID)
+									Expect(err).To(BeNil())
+									return task
+								}, "1m").Should(MatchFields(IgnoreExtras, Fields{
+									"State": BeEquivalentTo(types.FileTaskStateDone),
+								}))
+							},
+							Config: initialConfig,
+							ConfigPlanChecks: resource.ConfigPlanChecks{
+								PreApply: []plancheck.PlanCheck{
+									plancheck.ExpectResourceAction("freebox_remote_file."+resourceName, plancheck.ResourceActionUpdate),
+								},
+							},
+							Check: resource.ComposeAggregateTestCheckFunc(
+								func(s *terraform.State) error {
+									_, err := freeboxClient.GetFileInfo(ctx, extractedPath)
+									Expect(err).To(BeNil(), "the file should be extracted again")
+
+									return nil
+								},
+								resource.TestCheckResourceAttr("freebox_remote_file."+resourceName, "extracted_files.#", "1"),
 							),
 						},
 					},
@@ -979,9 +1080,8 @@ var _ = Context(`resource "freebox_remote_file" { ... }`, func() {
 						_, err := freeboxClient.GetFileInfo(ctx, exampleFile.filepath)
 						Expect(err).To(MatchError(client.ErrPathNotFound), "file %s should not exist", exampleFile.filepath)
 
-						// TODO: remove extract file at the end of the test
-						// _, err = freeboxClient.GetFileInfo(ctx, extractedPath)
-						// Expect(err).To(MatchError(client.ErrPathNotFound), "extracted file %a should not exist", extractedPath)
+						_, err = freeboxClient.GetFileInfo(ctx, extractedPath)
+						Expect(err).To(MatchError(client.ErrPathNotFound), "extracted file %s should not exist", extractedPath)
 
 						return nil
 					},
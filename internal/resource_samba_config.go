@@ -0,0 +1,251 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &sambaConfigResource{}
+)
+
+func NewSambaConfigResource() resource.Resource {
+	return &sambaConfigResource{}
+}
+
+type sambaConfigResource struct {
+	client client.Client
+}
+
+type sambaConfigModel struct {
+	ID                types.String `tfsdk:"id"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Workgroup         types.String `tfsdk:"workgroup"`
+	LogonEnabled      types.Bool   `tfsdk:"logon_enabled"`
+	LogonUser         types.String `tfsdk:"logon_user"`
+	LogonPassword     types.String `tfsdk:"logon_password"`
+	PrintShareEnabled types.Bool   `tfsdk:"print_share_enabled"`
+	SMBv2Enabled      types.Bool   `tfsdk:"smbv2_enabled"`
+}
+
+// toPayload applies the values of the model on top of the current Samba configuration.
+func (m *sambaConfigModel) toPayload(current freeboxTypes.SambaConfig) freeboxTypes.SambaConfig {
+	payload := current
+	payload.FileShareEnabled = m.Enabled.ValueBool()
+	payload.Workgroup = m.Workgroup.ValueString()
+	payload.LogonEnabled = m.LogonEnabled.ValueBool()
+	payload.LogonUser = m.LogonUser.ValueString()
+	payload.PrintShareEnabled = m.PrintShareEnabled.ValueBool()
+	payload.SMBv2Enabled = m.SMBv2Enabled.ValueBool()
+
+	// The password is never returned by the Freebox, the current one is kept when unset
+	if !m.LogonPassword.IsNull() && !m.LogonPassword.IsUnknown() {
+		payload.LogonPassword = m.LogonPassword.ValueString()
+	}
+
+	return payload
+}
+
+// fromClientType sets the model from the Samba configuration. The logon password is never
+// returned by the Freebox, so the one of the model is kept.
+func (m *sambaConfigModel) fromClientType(config freeboxTypes.SambaConfig) {
+	m.ID = basetypes.NewStringValue("samba")
+	m.Enabled = basetypes.NewBoolValue(config.FileShareEnabled)
+	m.Workgroup = basetypes.NewStringValue(config.Workgroup)
+	m.LogonEnabled = basetypes.NewBoolValue(config.LogonEnabled)
+	m.PrintShareEnabled = basetypes.NewBoolValue(config.PrintShareEnabled)
+	m.SMBv2Enabled = basetypes.NewBoolValue(config.SMBv2Enabled)
+	if config.LogonUser != "" || !m.LogonUser.IsNull() {
+		m.LogonUser = basetypes.NewStringValue(config.LogonUser)
+	}
+}
+
+func (v *sambaConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_samba_config"
+}
+
+func (v *sambaConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the Samba (SMB) file and printer sharing of the Freebox. This is a singleton resource: only one Samba configuration exists per Freebox. Destroying this resource disables the file and printer sharing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier for the singleton Samba configuration resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the file sharing is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"workgroup": schema.StringAttribute{
+				MarkdownDescription: "Workgroup the Freebox belongs to",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("WORKGROUP"),
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 15),
+				},
+			},
+			"logon_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether an authentication with `logon_user` and `logon_password` is required to access the shares",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"logon_user": schema.StringAttribute{
+				MarkdownDescription: "User name to access the shares",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("logon_password")),
+				},
+			},
+			"logon_password": schema.StringAttribute{
+				MarkdownDescription: "Password to access the shares, the current one is kept if not set. It is hidden from the plan output but stored in the Terraform state",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("logon_user")),
+				},
+			},
+			"print_share_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the printers connected to the Freebox are shared",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"smbv2_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SMBv2 protocol is enabled. The Freebox has no setting to refuse the insecure SMBv1",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (v *sambaConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *sambaConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model sambaConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateSingletonConfig(ctx, v.client.GetSambaConfig, v.client.UpdateSambaConfig, model.toPayload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure Samba",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *sambaConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model sambaConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.GetSambaConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read Samba config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *sambaConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model sambaConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updateSingletonConfig(ctx, v.client.GetSambaConfig, v.client.UpdateSambaConfig, model.toPayload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update Samba config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *sambaConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model sambaConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := updateSingletonConfig(ctx, v.client.GetSambaConfig, v.client.UpdateSambaConfig, func(current freeboxTypes.SambaConfig) freeboxTypes.SambaConfig {
+		current.FileShareEnabled = false
+		current.PrintShareEnabled = false
+		return current
+	}); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable Samba",
+			err.Error(),
+		)
+	}
+}
+
+func (v *sambaConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "samba")...)
+}
@@ -0,0 +1,101 @@
+package internal_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_samba_config" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		originalConfig freeboxTypes.SambaConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		originalConfig, err = freeboxClient.GetSambaConfig(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateSambaConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original Samba config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_samba_config" "` + resName + `" {
+				workgroup      = "TESTING"
+				logon_enabled  = true
+				logon_user     = "` + resName + `"
+				logon_password = "` + resName + `"
+				smbv2_enabled  = true
+			}
+		`
+	})
+
+	It("should configure, update, import and disable the Samba file sharing", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_samba_config."+resName, "id", "samba"),
+						resource.TestCheckResourceAttr("freebox_samba_config."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_samba_config."+resName, "workgroup", "TESTING"),
+						resource.TestCheckResourceAttr("freebox_samba_config."+resName, "logon_user", resName),
+						resource.TestCheckResourceAttr("freebox_samba_config."+resName, "print_share_enabled", "false"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetSambaConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.FileShareEnabled).To(BeTrue())
+							Expect(config.Workgroup).To(Equal("TESTING"))
+							Expect(config.LogonEnabled).To(BeTrue())
+							Expect(config.LogonUser).To(Equal(resName))
+							Expect(config.SMBv2Enabled).To(BeTrue())
+							return nil
+						},
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("workgroup", "UPDATED")(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_samba_config."+resName, "workgroup", "UPDATED"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetSambaConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.Workgroup).To(Equal("UPDATED"))
+							return nil
+						},
+					),
+				},
+				{
+					Config:                  terraformConfigWithAttribute("workgroup", "UPDATED")(config),
+					ResourceName:            "freebox_samba_config." + resName,
+					ImportState:             true,
+					ImportStateId:           "samba",
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"logon_password"},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetSambaConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(config.FileShareEnabled).To(BeFalse())
+				Expect(config.PrintShareEnabled).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
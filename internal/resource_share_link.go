@@ -0,0 +1,249 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ resource.Resource                = &shareLinkResource{}
+	_ resource.ResourceWithImportState = &shareLinkResource{}
+)
+
+func NewShareLinkResource() resource.Resource {
+	return &shareLinkResource{}
+}
+
+// shareLinkResource defines the resource implementation.
+type shareLinkResource struct {
+	client client.Client
+}
+
+// shareLinkModel describes the resource data model.
+type shareLinkModel struct {
+	ID        types.String      `tfsdk:"id"`
+	Path      types.String      `tfsdk:"path"`
+	ExpiresAt timetypes.RFC3339 `tfsdk:"expires_at"`
+	Name      types.String      `tfsdk:"name"`
+	Token     types.String      `tfsdk:"token"`
+	URL       types.String      `tfsdk:"url"`
+}
+
+func (m *shareLinkModel) toPayload() (freeboxTypes.ShareLinkPayload, diag.Diagnostics) {
+	payload := freeboxTypes.ShareLinkPayload{
+		Path: freeboxTypes.Base64Path(m.Path.ValueString()),
+	}
+
+	if !m.ExpiresAt.IsNull() {
+		expiresAt, diags := m.ExpiresAt.ValueRFC3339Time()
+		if diags.HasError() {
+			return payload, diags
+		}
+		payload.Expire = expiresAt.Unix()
+	}
+
+	return payload, nil
+}
+
+// fromClientType sets the model from the share link. The path and the expiration date of
+// the model are kept as written in the configuration, and only set when importing.
+func (m *shareLinkModel) fromClientType(link freeboxTypes.ShareLink) {
+	m.ID = basetypes.NewStringValue(link.Token)
+	m.Name = basetypes.NewStringValue(link.Name)
+	m.Token = basetypes.NewStringValue(link.Token)
+	m.URL = basetypes.NewStringValue(link.FullURL)
+
+	if m.Path.IsNull() {
+		m.Path = basetypes.NewStringValue(string(link.Path))
+	}
+	if m.ExpiresAt.IsNull() && link.Expire > 0 {
+		m.ExpiresAt = timetypes.NewRFC3339TimeValue(time.Unix(link.Expire, 0).UTC())
+	}
+}
+
+func (v *shareLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_share_link"
+}
+
+func (v *shareLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a public link to download a file or a directory of the Freebox storage. Destroying this resource revokes the link.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the share link, which is its token",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path to the shared file or directory on the Freebox (e.g. the `destination_path` of a `freebox_remote_file`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					models.FilePathValidator(path.Root("path")),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiration date of the link as an RFC3339 timestamp. If not set, the link never expires",
+				CustomType:          timetypes.RFC3339Type{},
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the shared file or directory",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token of the share link, which is the secret part of its URL",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Full public URL of the share link",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (v *shareLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *shareLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model shareLinkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := model.toPayload()
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	link, err := v.client.CreateShareLink(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create share link",
+			fmt.Sprintf("Path: %s, Error: %s", model.Path.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(link)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *shareLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model shareLinkModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := v.client.GetShareLink(ctx, model.ID.ValueString())
+	if err != nil {
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+			resp.State.RemoveResource(ctx) // Expired or revoked
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to get share link",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(link)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *shareLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model shareLinkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument requires a replacement: there is nothing to update on the Freebox.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *shareLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model shareLinkModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := v.client.DeleteShareLink(ctx, model.ID.ValueString()); err != nil {
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+			return // Already expired
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete share link",
+			err.Error(),
+		)
+	}
+}
+
+func (v *shareLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
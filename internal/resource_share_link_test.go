@@ -0,0 +1,84 @@
+package internal_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nikolalohinski/free-go/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_share_link" { ... }`, func() {
+	var (
+		resName   string
+		config    string
+		expiresAt string
+	)
+
+	BeforeEach(func() {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+		expiresAt = time.Now().Add(time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_share_link" "` + resName + `" {
+				path       = "` + existingDisk.filepath + `"
+				expires_at = "` + expiresAt + `"
+			}
+		`
+	})
+
+	It("should create, import and revoke a share link", func(ctx SpecContext) {
+		var token string
+
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_share_link."+resName, "path", existingDisk.filepath),
+						resource.TestCheckResourceAttr("freebox_share_link."+resName, "expires_at", expiresAt),
+						resource.TestCheckResourceAttr("freebox_share_link."+resName, "name", existingDisk.filename),
+						resource.TestCheckResourceAttrSet("freebox_share_link."+resName, "token"),
+						resource.TestCheckResourceAttrPair("freebox_share_link."+resName, "id", "freebox_share_link."+resName, "token"),
+						func(s *terraform.State) error {
+							state := s.RootModule().Resources["freebox_share_link."+resName].Primary.Attributes
+							token = state["token"]
+							Expect(state["url"]).To(ContainSubstring(token))
+
+							link, err := freeboxClient.GetShareLink(ctx, token)
+							Expect(err).To(BeNil())
+							Expect(link.FullURL).To(Equal(state["url"]))
+							Expect(link.Expire).To(BeNumerically("==", time.Now().Add(time.Hour).Unix(), 60))
+							return nil
+						},
+					),
+				},
+				{
+					Config:            config,
+					ResourceName:      "freebox_share_link." + resName,
+					ImportState:       true,
+					ImportStateVerify: true,
+					ImportStateVerifyIgnore: []string{
+						"path", // The Freebox returns the path in its own format
+					},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				_, err := freeboxClient.GetShareLink(ctx, token)
+				var apiErr *client.APIError
+				Expect(errors.As(err, &apiErr)).To(BeTrue())
+				Expect(apiErr.Code).To(Equal("noent"))
+				return nil
+			},
+		})
+	})
+})
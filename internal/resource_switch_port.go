@@ -0,0 +1,221 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.Resource                = &switchPortResource{}
+	_ resource.ResourceWithImportState = &switchPortResource{}
+)
+
+func NewSwitchPortResource() resource.Resource {
+	return &switchPortResource{}
+}
+
+// switchPortResource defines the resource implementation.
+type switchPortResource struct {
+	client client.Client
+}
+
+// switchPortModel describes the resource data model.
+type switchPortModel struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Speed   types.String `tfsdk:"speed"`
+	Duplex  types.String `tfsdk:"duplex"`
+}
+
+// toPayload applies the values of the model on top of the current configuration of the port.
+func (m *switchPortModel) toPayload(current freeboxTypes.SwitchPortConfig) freeboxTypes.SwitchPortConfig {
+	payload := current
+	payload.Enabled = m.Enabled.ValueBool()
+	payload.Speed = m.Speed.ValueString()
+	payload.Duplex = m.Duplex.ValueString()
+	return payload
+}
+
+func (m *switchPortModel) fromClientType(config freeboxTypes.SwitchPortConfig) {
+	m.ID = basetypes.NewInt64Value(config.ID)
+	m.Enabled = basetypes.NewBoolValue(config.Enabled)
+	m.Speed = basetypes.NewStringValue(config.Speed)
+	m.Duplex = basetypes.NewStringValue(config.Duplex)
+}
+
+func (v *switchPortResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_port"
+}
+
+func (v *switchPortResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the configuration of an Ethernet port of the switch of the Freebox. The ports always exist on the Freebox: destroying this resource enables the port and restores the auto-negotiation. Beware of disabling the port Terraform reaches the Freebox through.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the port, as listed by the `freebox_switch_ports` data source",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the port is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"speed": schema.StringAttribute{
+				MarkdownDescription: "Speed of the port in Mbit/s (`10`, `100`, `1000`) or `auto` to negotiate it",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("auto"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "10", "100", "1000"),
+				},
+			},
+			"duplex": schema.StringAttribute{
+				MarkdownDescription: "Duplex of the port (`half`, `full`) or `auto` to negotiate it",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("auto"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "half", "full"),
+				},
+			},
+		},
+	}
+}
+
+func (v *switchPortResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *switchPortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model switchPortModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *switchPortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model switchPortModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := v.client.GetSwitchPortConfig(ctx, model.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read switch port",
+			fmt.Sprintf("Failed to read switch port %d: %s", model.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	model.fromClientType(config)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *switchPortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model switchPortModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *switchPortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model switchPortModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Enabled = basetypes.NewBoolValue(true)
+	model.Speed = basetypes.NewStringValue("auto")
+	model.Duplex = basetypes.NewStringValue("auto")
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+}
+
+func (v *switchPortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected the import identifier to be an int64 but got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// apply merges the planned configuration into the current one of the port and pushes it to the Freebox.
+func (v *switchPortResource) apply(ctx context.Context, model *switchPortModel) (diagnostics diag.Diagnostics) {
+	current, err := v.client.GetSwitchPortConfig(ctx, model.ID.ValueInt64())
+	if err != nil {
+		diagnostics.AddError("Failed to read switch port", fmt.Sprintf("Failed to read switch port %d: %s", model.ID.ValueInt64(), err))
+		return
+	}
+
+	config, err := v.client.UpdateSwitchPortConfig(ctx, model.ID.ValueInt64(), model.toPayload(current))
+	if err != nil {
+		diagnostics.AddError("Failed to update switch port", fmt.Sprintf("Failed to update switch port %d: %s", model.ID.ValueInt64(), err))
+		return
+	}
+
+	model.fromClientType(config)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_switch_port" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		portID         int64
+		originalConfig freeboxTypes.SwitchPortConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		ports, err := freeboxClient.ListSwitchPortStatuses(ctx)
+		Expect(err).To(BeNil())
+
+		// An unused port is required so that disabling it does not cut any host off while testing
+		portID = -1
+		for _, port := range ports {
+			if port.Link == "down" {
+				portID = port.ID
+				break
+			}
+		}
+		if portID == -1 {
+			Skip("no unused switch port to test with")
+		}
+
+		originalConfig, err = freeboxClient.GetSwitchPortConfig(ctx, portID)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateSwitchPortConfig(ctx, portID, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original switch port config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + fmt.Sprintf(`
+			resource "freebox_switch_port" "%s" {
+				id      = %d
+				speed   = "100"
+				duplex  = "full"
+				enabled = true
+			}
+		`, resName, portID)
+	})
+
+	It("should configure, update, import and reset a switch port", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_switch_port."+resName, "id", fmt.Sprintf("%d", portID)),
+						resource.TestCheckResourceAttr("freebox_switch_port."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_switch_port."+resName, "speed", "100"),
+						resource.TestCheckResourceAttr("freebox_switch_port."+resName, "duplex", "full"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetSwitchPortConfig(ctx, portID)
+							Expect(err).To(BeNil())
+							Expect(config.Speed).To(Equal("100"))
+							Expect(config.Duplex).To(Equal("full"))
+							return nil
+						},
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("enabled", false)(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_switch_port."+resName, "enabled", "false"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetSwitchPortConfig(ctx, portID)
+							Expect(err).To(BeNil())
+							Expect(config.Enabled).To(BeFalse())
+							return nil
+						},
+					),
+				},
+				{
+					Config:            terraformConfigWithAttribute("enabled", false)(config),
+					ResourceName:      "freebox_switch_port." + resName,
+					ImportState:       true,
+					ImportStateId:     fmt.Sprintf("%d", portID),
+					ImportStateVerify: true,
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetSwitchPortConfig(ctx, portID)
+				Expect(err).To(BeNil())
+				Expect(config.Enabled).To(BeTrue())
+				Expect(config.Speed).To(Equal("auto"))
+				Expect(config.Duplex).To(Equal("auto"))
+				return nil
+			},
+		})
+	})
+})
@@ -0,0 +1,192 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &upnpIGDConfigResource{}
+)
+
+func NewUPnPIGDConfigResource() resource.Resource {
+	return &upnpIGDConfigResource{}
+}
+
+type upnpIGDConfigResource struct {
+	client client.Client
+}
+
+type upnpIGDConfigModel struct {
+	ID      types.String `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Version types.Int64  `tfsdk:"version"`
+}
+
+func (m *upnpIGDConfigModel) toPayload() freeboxTypes.UPnPIGDConfig {
+	payload := freeboxTypes.UPnPIGDConfig{
+		Enabled: m.Enabled.ValueBool(),
+	}
+	if !m.Version.IsNull() && !m.Version.IsUnknown() {
+		payload.Version = m.Version.ValueInt64()
+	}
+	return payload
+}
+
+func (m *upnpIGDConfigModel) fromClientType(config freeboxTypes.UPnPIGDConfig) {
+	m.ID = basetypes.NewStringValue("upnpigd")
+	m.Enabled = basetypes.NewBoolValue(config.Enabled)
+	m.Version = basetypes.NewInt64Value(config.Version)
+}
+
+func (v *upnpIGDConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_upnp_igd_config"
+}
+
+func (v *upnpIGDConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the UPnP IGD (Internet Gateway Device) configuration of the Freebox, which lets devices of the local network open ports on their own. This is a singleton resource: only one UPnP IGD configuration exists per Freebox. Destroying this resource disables UPnP IGD.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier for the singleton UPnP IGD configuration resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether UPnP IGD is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the UPnP IGD protocol (`1` or `2`). If not set, the current version is kept",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2),
+				},
+			},
+		},
+	}
+}
+
+func (v *upnpIGDConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *upnpIGDConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model upnpIGDConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.UpdateUPnPIGDConfig(ctx, model.toPayload())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to configure UPnP IGD",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *upnpIGDConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model upnpIGDConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.GetUPnPIGDConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read UPnP IGD config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *upnpIGDConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model upnpIGDConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := v.client.UpdateUPnPIGDConfig(ctx, model.toPayload())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update UPnP IGD config",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *upnpIGDConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model upnpIGDConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := model.toPayload()
+	payload.Enabled = false
+
+	if _, err := v.client.UpdateUPnPIGDConfig(ctx, payload); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable UPnP IGD",
+			err.Error(),
+		)
+	}
+}
+
+func (v *upnpIGDConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "upnpigd")...)
+}
@@ -0,0 +1,96 @@
+package internal_test
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_upnp_igd_config" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		originalConfig freeboxTypes.UPnPIGDConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		originalConfig, err = freeboxClient.GetUPnPIGDConfig(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateUPnPIGDConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original UPnP IGD config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_upnp_igd_config" "` + resName + `" {
+				enabled = true
+				version = ` + strconv.FormatInt(originalConfig.Version, 10) + `
+			}
+		`
+	})
+
+	It("should enable, disable and import the UPnP IGD configuration", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_upnp_igd_config."+resName, "id", "upnpigd"),
+						resource.TestCheckResourceAttr("freebox_upnp_igd_config."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_upnp_igd_config."+resName, "version", strconv.FormatInt(originalConfig.Version, 10)),
+						func(s *terraform.State) error {
+							upnpConfig, err := freeboxClient.GetUPnPIGDConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(upnpConfig.Enabled).To(BeTrue())
+							return nil
+						},
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("enabled", false)(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_upnp_igd_config."+resName, "enabled", "false"),
+						func(s *terraform.State) error {
+							upnpConfig, err := freeboxClient.GetUPnPIGDConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(upnpConfig.Enabled).To(BeFalse())
+							return nil
+						},
+					),
+				},
+				{
+					Config:        terraformConfigWithAttribute("enabled", false)(config),
+					ResourceName:  "freebox_upnp_igd_config." + resName,
+					ImportState:   true,
+					ImportStateId: "upnpigd",
+					ImportStateCheck: func(states []*terraform.InstanceState) error {
+						Expect(states).To(HaveLen(1))
+						Expect(states[0].ID).To(Equal("upnpigd"))
+						Expect(states[0].Attributes["enabled"]).To(Equal("false"))
+						return nil
+					},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				upnpConfig, err := freeboxClient.GetUPnPIGDConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(upnpConfig.Enabled).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
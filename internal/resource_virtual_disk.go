@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -31,6 +32,7 @@ import (
 var (
 	_ resource.Resource                = &virtualDiskResource{}
 	_ resource.ResourceWithImportState = &virtualDiskResource{}
+	_ resource.ResourceWithModifyPlan  = &virtualDiskResource{}
 )
 
 func NewVirtualDiskResource() resource.Resource {
@@ -54,6 +56,8 @@ type virtualDiskModel struct {
 	VirtualSize types.Int64 `tfsdk:"virtual_size"`
 	// SizeOnDisk is the space used by virtual image on disk. This is how much filesystem space is consumed on the box.
 	SizeOnDisk types.Int64 `tfsdk:"size_on_disk"`
+	// FreeSpaceMargin is the space in bytes to keep free on the partition of the virtual disk.
+	FreeSpaceMargin types.Int64 `tfsdk:"free_space_margin"`
 
 	// polling is the polling configuration.
 	Polling types.Object `tfsdk:"polling"`
@@ -233,6 +237,13 @@ func (v *virtualDiskResource) Schema(ctx context.Context, req resource.SchemaReq
 					models.DiskSizeValidator(),
 				},
 			},
+			"free_space_margin": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Space in bytes to keep free on the partition of the virtual disk. The plan fails early when a new or replaced `raw` disk, or the growth of a `raw` disk, and this margin do not fit. `qcow2` disks grow on demand and are not checked (default: `0`)",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"polling": schema.SingleNestedAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -261,6 +272,83 @@ func (v *virtualDiskResource) Configure(ctx context.Context, req resource.Config
 	v.client = client
 }
 
+func (v *virtualDiskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || v.client == nil {
+		return // Destroying or provider not configured yet
+	}
+
+	var plan virtualDiskModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Path.IsUnknown() || plan.FreeSpaceMargin.IsUnknown() {
+		return // Can not be checked until the values are known
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(v.checkFreeSpace(ctx, &plan, 0)...)
+		return
+	}
+
+	var state virtualDiskModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !plan.Type.IsUnknown() && !plan.Type.Equal(state.Type):
+		// The disk is replaced, the space of the previous one is freed first
+		resp.Diagnostics.Append(v.checkFreeSpace(ctx, &plan, state.SizeOnDisk.ValueInt64())...)
+	case state.Type.ValueString() == freeboxTypes.RawDisk && !plan.VirtualSize.IsUnknown() && plan.VirtualSize.ValueInt64() > state.VirtualSize.ValueInt64():
+		// A raw disk is fully allocated, growing it takes the difference on the partition
+		growth := plan.VirtualSize.ValueInt64() - state.VirtualSize.ValueInt64()
+		resp.Diagnostics.Append(checkFreeSpace(ctx, v.client, plan.Path.ValueString(), growth, plan.FreeSpaceMargin.ValueInt64())...)
+	}
+}
+
+// checkFreeSpace verifies the partition of the virtual disk can hold a fully allocated raw image,
+// once the given space of a replaced disk is freed.
+func (v *virtualDiskResource) checkFreeSpace(ctx context.Context, model *virtualDiskModel, freed int64) diag.Diagnostics {
+	expected := v.expectedSize(ctx, model)
+	if expected > 0 {
+		expected = max(expected-freed, 0)
+	}
+
+	return checkFreeSpace(ctx, v.client, model.Path.ValueString(), expected, model.FreeSpaceMargin.ValueInt64())
+}
+
+// expectedSize returns the size in bytes a raw virtual disk takes on the partition, or -1 when it is not known in advance.
+func (v *virtualDiskResource) expectedSize(ctx context.Context, model *virtualDiskModel) int64 {
+	diskType, virtualSize := model.Type, model.VirtualSize
+
+	if !model.ResizeFrom.IsNull() {
+		if model.ResizeFrom.IsUnknown() {
+			return -1
+		}
+
+		diskInfo, err := v.client.GetVirtualDiskInfo(ctx, model.ResizeFrom.ValueString())
+		if err != nil {
+			return -1 // Reported when copying the disk
+		}
+
+		diskType = basetypes.NewStringValue(string(diskInfo.Type))
+		if virtualSize.IsNull() || virtualSize.IsUnknown() || virtualSize.ValueInt64() < diskInfo.VirtualSize {
+			virtualSize = basetypes.NewInt64Value(diskInfo.VirtualSize) // The copy is as large as its source
+		}
+	}
+
+	if diskType.ValueString() != freeboxTypes.RawDisk || virtualSize.IsNull() || virtualSize.IsUnknown() {
+		return -1
+	}
+
+	return virtualSize.ValueInt64()
+}
+
 func (v *virtualDiskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model virtualDiskModel
 
@@ -282,6 +370,11 @@ func (v *virtualDiskResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (v *virtualDiskResource) create(ctx context.Context, model *virtualDiskModel, private providerdata.Setter) (diagnostics diag.Diagnostics) {
+	if diags := v.checkFreeSpace(ctx, model, 0); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
 	if !model.ResizeFrom.IsNull() {
 		if diags := v.createFromExistingDisk(ctx, model.ResizeFrom.ValueString(), model, private); diags.HasError() {
 			diagnostics.Append(diags...)
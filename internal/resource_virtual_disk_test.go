@@ -2,6 +2,7 @@ package internal_test
 
 import (
 	"context"
+	"math"
 	"path"
 	"regexp"
 	"strconv"
@@ -55,6 +56,7 @@ var _ = Context(`resource "freebox_virtual_disk" { ... }`, func() {
 				type = "` + exampleDisk.diskType + `"
 				virtual_size = ` + strconv.Itoa(originalvirtualSize) + `
 				resize_from = null
+				free_space_margin = null
 			}
 		`
 	})
@@ -167,6 +169,35 @@ var _ = Context(`resource "freebox_virtual_disk" { ... }`, func() {
 				})
 			})
 		})
+
+		Context("when the partition does not have enough free space", func() {
+			BeforeEach(func(ctx SpecContext) {
+				exampleDisk.diskType = freeboxTypes.RawDisk
+			})
+
+			JustBeforeEach(func(ctx SpecContext) {
+				// The largest margin can not fit on any partition, nor overflow once added to the size of the disk
+				initialConfig = terraformConfigWithAttribute("free_space_margin", int64(math.MaxInt64))(initialConfig)
+			})
+
+			It("should fail before creating the disk", func(ctx SpecContext) {
+				resource.UnitTest(GinkgoT(), resource.TestCase{
+					ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+					Steps: []resource.TestStep{
+						{
+							Config:      initialConfig,
+							ExpectError: regexp.MustCompile(`Not\s+enough\s+free\s+space`),
+						},
+					},
+					CheckDestroy: func(s *terraform.State) error {
+						_, err := freeboxClient.GetFileInfo(ctx, exampleDisk.filepath)
+						Expect(err).To(MatchError(client.ErrPathNotFound), "file %s should not exist", exampleDisk.filepath)
+
+						return nil
+					},
+				})
+			})
+		})
 	})
 
 	Context("create, update and delete", func() {
@@ -3,14 +3,24 @@ package internal
 import (
 	"context"
 	"fmt"
-
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/nikolalohinski/free-go/client"
@@ -29,29 +39,63 @@ type vpnServerResource struct {
 	client client.Client
 }
 
+// vpnServerProtocols are the transport protocols supported by the OpenVPN server.
+var vpnServerProtocols = []string{"udp", "tcp"}
+
+// vpnServerCiphers are the data channel ciphers supported by the OpenVPN server.
+var vpnServerCiphers = []string{"blowfish", "aes128", "aes256"}
+
 type vpnServerModel struct {
 	ID         types.String `tfsdk:"id"`
 	Enabled    types.Bool   `tfsdk:"enabled"`
 	ServerPort types.Int64  `tfsdk:"server_port"`
 	ServerIP   types.String `tfsdk:"server_ip"`
 	ServerMask types.String `tfsdk:"server_mask"`
+	ServerIPv6 types.String `tfsdk:"server_ipv6"`
+	Protocol   types.String `tfsdk:"protocol"`
+	Cipher     types.String `tfsdk:"cipher"`
 	PushDHCP   types.Bool   `tfsdk:"push_dhcp"`
+	PushDNS    types.Bool   `tfsdk:"push_dns"`
+	PushRoutes types.List   `tfsdk:"push_routes"`
+	MaxClients types.Int64  `tfsdk:"max_clients"`
 	CA         types.String `tfsdk:"ca"`
 }
 
-func (m *vpnServerModel) toPayload() freeboxTypes.OpenVPNServerConfig {
-	payload := freeboxTypes.OpenVPNServerConfig{
-		Enabled:    m.Enabled.ValueBool(),
-		ServerPort: m.ServerPort.ValueInt64(),
-		PushDHCP:   m.PushDHCP.ValueBool(),
-	}
+// toPayload overlays the known values of the model onto the current configuration of the server.
+func (m *vpnServerModel) toPayload(ctx context.Context, current freeboxTypes.OpenVPNServerConfig) (payload freeboxTypes.OpenVPNServerConfig, diagnostics diag.Diagnostics) {
+	payload = current
+	payload.Enabled = m.Enabled.ValueBool()
+	payload.ServerPort = m.ServerPort.ValueInt64()
+	payload.PushDHCP = m.PushDHCP.ValueBool()
+
 	if !m.ServerIP.IsNull() && !m.ServerIP.IsUnknown() {
 		payload.ServerIP = m.ServerIP.ValueString()
 	}
 	if !m.ServerMask.IsNull() && !m.ServerMask.IsUnknown() {
 		payload.ServerMask = m.ServerMask.ValueString()
 	}
-	return payload
+	if !m.ServerIPv6.IsNull() && !m.ServerIPv6.IsUnknown() {
+		payload.ServerIPv6 = m.ServerIPv6.ValueString()
+	}
+	if !m.Protocol.IsNull() && !m.Protocol.IsUnknown() {
+		payload.Protocol = m.Protocol.ValueString()
+	}
+	if !m.Cipher.IsNull() && !m.Cipher.IsUnknown() {
+		payload.Cipher = m.Cipher.ValueString()
+	}
+	if !m.PushDNS.IsNull() && !m.PushDNS.IsUnknown() {
+		payload.PushDNS = m.PushDNS.ValueBool()
+	}
+	if m.PushRoutes.IsNull() {
+		payload.PushRoutes = []string{} // Unsetting the attribute removes the routes
+	} else if !m.PushRoutes.IsUnknown() {
+		diagnostics.Append(m.PushRoutes.ElementsAs(ctx, &payload.PushRoutes, false)...)
+	}
+	if !m.MaxClients.IsNull() && !m.MaxClients.IsUnknown() {
+		payload.MaxClients = m.MaxClients.ValueInt64()
+	}
+
+	return
 }
 
 func (m *vpnServerModel) fromClientType(config freeboxTypes.OpenVPNServerConfig) {
@@ -60,8 +104,22 @@ func (m *vpnServerModel) fromClientType(config freeboxTypes.OpenVPNServerConfig)
 	m.ServerPort = basetypes.NewInt64Value(config.ServerPort)
 	m.ServerIP = basetypes.NewStringValue(config.ServerIP)
 	m.ServerMask = basetypes.NewStringValue(config.ServerMask)
+	m.ServerIPv6 = basetypes.NewStringValue(config.ServerIPv6)
+	m.Protocol = basetypes.NewStringValue(config.Protocol)
+	m.Cipher = basetypes.NewStringValue(config.Cipher)
 	m.PushDHCP = basetypes.NewBoolValue(config.PushDHCP)
+	m.PushDNS = basetypes.NewBoolValue(config.PushDNS)
+	m.MaxClients = basetypes.NewInt64Value(config.MaxClients)
 	m.CA = basetypes.NewStringValue(config.CA)
+
+	// Keep the attribute unset if it was not set in the first place
+	if len(config.PushRoutes) != 0 || !m.PushRoutes.IsNull() {
+		routes := make([]attr.Value, 0, len(config.PushRoutes))
+		for _, route := range config.PushRoutes {
+			routes = append(routes, basetypes.NewStringValue(route))
+		}
+		m.PushRoutes = basetypes.NewListValueMust(types.StringType, routes)
+	}
 }
 
 func (v *vpnServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,7 +144,7 @@ func (v *vpnServerResource) Schema(ctx context.Context, req resource.SchemaReque
 				Default:             booldefault.StaticBool(true),
 			},
 			"server_port": schema.Int64Attribute{
-				MarkdownDescription: "UDP port the OpenVPN server listens on (default 1194)",
+				MarkdownDescription: "Port the OpenVPN server listens on, with the transport set by `protocol` (default 1194)",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(1194),
@@ -101,12 +159,70 @@ func (v *vpnServerResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 				Computed:            true,
 			},
+			"server_ipv6": schema.StringAttribute{
+				MarkdownDescription: "VPN IPv6 subnet (e.g. \"fd00:8::/64\"), empty to only serve IPv4",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Transport protocol of the OpenVPN server, `tcp` helps clients behind networks blocking UDP (one of: `" + strings.Join(vpnServerProtocols, "`, `") + "`)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(vpnServerProtocols...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cipher": schema.StringAttribute{
+				MarkdownDescription: "Cipher of the data channel (one of: `" + strings.Join(vpnServerCiphers, "`, `") + "`)",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(vpnServerCiphers...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"push_dhcp": schema.BoolAttribute{
 				MarkdownDescription: "Whether to push DHCP settings to clients",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"push_dns": schema.BoolAttribute{
+				MarkdownDescription: "Whether to push the Freebox DNS servers to clients",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"push_routes": schema.ListAttribute{
+				MarkdownDescription: "Routes pushed to clients, in CIDR notation (e.g. \"192.168.1.0/24\"). No route is pushed when unset",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-fA-F:.]+/[0-9]{1,3}$`), "must be a route in CIDR notation")),
+				},
+			},
+			"max_clients": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of simultaneous clients",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"ca": schema.StringAttribute{
 				MarkdownDescription: "CA certificate in PEM format (read-only, set by Freebox)",
 				Computed:            true,
@@ -144,7 +260,13 @@ func (v *vpnServerResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	response, err := v.client.UpdateOpenVPNServerConfig(ctx, model.toPayload())
+	payload, diags := v.payload(ctx, &model)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	response, err := v.client.UpdateOpenVPNServerConfig(ctx, payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to configure OpenVPN server",
@@ -188,7 +310,13 @@ func (v *vpnServerResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	response, err := v.client.UpdateOpenVPNServerConfig(ctx, model.toPayload())
+	payload, diags := v.payload(ctx, &model)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	response, err := v.client.UpdateOpenVPNServerConfig(ctx, payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to update OpenVPN server config",
@@ -210,7 +338,12 @@ func (v *vpnServerResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	payload := model.toPayload()
+	payload, diags := v.payload(ctx, &model)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	payload.Enabled = false
 
 	if _, err := v.client.UpdateOpenVPNServerConfig(ctx, payload); err != nil {
@@ -221,6 +354,20 @@ func (v *vpnServerResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+// payload builds the configuration to send from the model and the current configuration of the server.
+func (v *vpnServerResource) payload(ctx context.Context, model *vpnServerModel) (payload freeboxTypes.OpenVPNServerConfig, diagnostics diag.Diagnostics) {
+	current, err := v.client.GetOpenVPNServerConfig(ctx)
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to read OpenVPN server config",
+			err.Error(),
+		)
+		return
+	}
+
+	return model.toPayload(ctx, current)
+}
+
 func (v *vpnServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "openvpn")...)
 }
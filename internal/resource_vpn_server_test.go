@@ -0,0 +1,100 @@
+package internal_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_vpn_server" { ... }`, func() {
+	var (
+		resName        string
+		config         string
+		originalConfig freeboxTypes.OpenVPNServerConfig
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		originalConfig, err = freeboxClient.GetOpenVPNServerConfig(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateOpenVPNServerConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original OpenVPN server config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_vpn_server" "` + resName + `" {
+				enabled     = true
+				protocol    = "tcp"
+				push_routes = ["192.168.1.0/24"]
+				max_clients = 10
+			}
+		`
+	})
+
+	It("should configure, update, import and disable the OpenVPN server", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_vpn_server."+resName, "id", "openvpn"),
+						resource.TestCheckResourceAttr("freebox_vpn_server."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_vpn_server."+resName, "protocol", "tcp"),
+						resource.TestCheckResourceAttr("freebox_vpn_server."+resName, "push_routes.#", "1"),
+						resource.TestCheckResourceAttr("freebox_vpn_server."+resName, "push_routes.0", "192.168.1.0/24"),
+						resource.TestCheckResourceAttr("freebox_vpn_server."+resName, "max_clients", "10"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetOpenVPNServerConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.Enabled).To(BeTrue())
+							Expect(config.Protocol).To(Equal("tcp"))
+							Expect(config.PushRoutes).To(ConsistOf("192.168.1.0/24"))
+							Expect(config.MaxClients).To(BeEquivalentTo(10))
+							return nil
+						},
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("push_routes", nil)(terraformConfigWithAttribute("max_clients", 5)(config)),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("freebox_vpn_server."+resName, "push_routes.#"),
+						resource.TestCheckResourceAttr("freebox_vpn_server."+resName, "max_clients", "5"),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetOpenVPNServerConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.PushRoutes).To(BeEmpty())
+							Expect(config.MaxClients).To(BeEquivalentTo(5))
+							return nil
+						},
+					),
+				},
+				{
+					Config:            terraformConfigWithAttribute("push_routes", nil)(terraformConfigWithAttribute("max_clients", 5)(config)),
+					ResourceName:      "freebox_vpn_server." + resName,
+					ImportState:       true,
+					ImportStateId:     "openvpn",
+					ImportStateVerify: true,
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetOpenVPNServerConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(config.Enabled).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
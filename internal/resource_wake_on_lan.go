@@ -0,0 +1,257 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ resource.Resource = &wakeOnLanResource{}
+)
+
+func NewWakeOnLanResource() resource.Resource {
+	return &wakeOnLanResource{}
+}
+
+// wakeOnLanResource defines the resource implementation.
+type wakeOnLanResource struct {
+	client client.Client
+}
+
+// wakeOnLanModel describes the resource data model.
+type wakeOnLanModel struct {
+	ID               types.String `tfsdk:"id"`
+	Interface        types.String `tfsdk:"interface"`
+	Mac              types.String `tfsdk:"mac"`
+	Password         types.String `tfsdk:"password"`
+	Triggers         types.Map    `tfsdk:"triggers"`
+	WaitForReachable types.Bool   `tfsdk:"wait_for_reachable"`
+	Polling          types.Object `tfsdk:"polling"`
+}
+
+// hostID returns the identifier of the host in the LAN browser.
+func (m *wakeOnLanModel) hostID() string {
+	return "ether-" + strings.ToLower(m.Mac.ValueString())
+}
+
+func (m *wakeOnLanModel) toPayload() freeboxTypes.WakeOnLanPayload {
+	payload := freeboxTypes.WakeOnLanPayload{
+		Mac: m.Mac.ValueString(),
+	}
+	if !m.Password.IsNull() && !m.Password.IsUnknown() {
+		payload.Password = m.Password.ValueString()
+	}
+	return payload
+}
+
+func (v *wakeOnLanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wake_on_lan"
+}
+
+func (v *wakeOnLanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a Wake-on-LAN request through the Freebox to a host of the local network. The request is sent on creation and every time the resource is replaced, which can be forced by changing the `triggers`. Destroying this resource is a no-op.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the Wake-on-LAN request",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Name of the LAN interface to send the request on (e.g. `pub`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"mac": schema.StringAttribute{
+				MarkdownDescription: "MAC address of the host to wake up",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`),
+						"Must be a valid MAC address",
+					),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "SecureOn password expected by the network card of the host, if any",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, sends a new Wake-on-LAN request",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_reachable": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the host to be reachable in the LAN browser after sending the request",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"polling": schema.SingleNestedAttribute{
+				MarkdownDescription: "Polling configuration used when waiting for the host to be reachable",
+				Optional:            true,
+				Computed:            true,
+				Attributes:          models.Polling{}.ResourceAttributes(),
+				Default:             objectdefault.StaticValue(models.NewPollingSpecModel(5*time.Second, 5*time.Minute)),
+			},
+		},
+	}
+}
+
+func (v *wakeOnLanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *wakeOnLanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model wakeOnLanModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := v.client.WakeOnLan(ctx, model.Interface.ValueString(), model.toPayload()); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to send Wake-on-LAN request",
+			fmt.Sprintf("Failed to wake %q on %q: %s", model.Mac.ValueString(), model.Interface.ValueString(), err),
+		)
+		return
+	}
+
+	model.ID = basetypes.NewStringValue(model.Interface.ValueString() + "/" + model.hostID())
+
+	if model.WaitForReachable.ValueBool() {
+		var polling models.Polling
+
+		resp.Diagnostics.Append(model.Polling.As(ctx, &polling, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if diags := waitForLanHostReachable(ctx, v.client, model.Interface.ValueString(), model.hostID(), polling); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wakeOnLanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A Wake-on-LAN request has no remote state; keep the state as is.
+}
+
+func (v *wakeOnLanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model wakeOnLanModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the waiting behavior can be updated in place, and it only applies to new requests.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wakeOnLanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A Wake-on-LAN request can not be undone; this is a no-op.
+}
+
+// waitForLanHostReachable waits for the host to be reachable in the LAN browser.
+func waitForLanHostReachable(ctx context.Context, c client.Client, interfaceName, hostID string, polling models.Polling) (diagnostics diag.Diagnostics) {
+	ctx = tflog.SetField(ctx, "host.interface", interfaceName)
+	ctx = tflog.SetField(ctx, "host.id", hostID)
+
+	interval, diags := polling.Interval.ValueGoDuration()
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	timeout, diags := polling.Timeout.ValueGoDuration()
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Debug(ctx, "Waiting for host to be reachable...", map[string]interface{}{
+		"timeout":  timeout,
+		"interval": interval,
+	})
+
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		host, err := c.GetLanInterfaceHost(ctx, interfaceName, hostID)
+		if err != nil {
+			tflog.Warn(ctx, "Failed to get LAN host. Retrying...", map[string]interface{}{
+				"error": err.Error(),
+			})
+		} else if host.Reachable {
+			return nil // Done
+		} else {
+			tflog.Debug(ctx, "Host not reachable yet")
+		}
+
+		select {
+		case <-ctx.Done():
+			diagnostics.AddError("Host did not become reachable in time", fmt.Sprintf("Host: %s, Interface: %s, Error: %v", hostID, interfaceName, ctx.Err()))
+			return
+		case <-tick.C:
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe(`resource "freebox_wake_on_lan" { ... }`, func() {
+	var (
+		resourceName string
+		config       string
+		mac          string
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resourceName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		mac = fmt.Sprintf("02:00:%02X:%02X:%02X:%02X",
+			randGenerator.Intn(256), randGenerator.Intn(256),
+			randGenerator.Intn(256), randGenerator.Intn(256),
+		)
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_wake_on_lan" "` + resourceName + `" {
+				interface = "pub"
+				mac       = "` + mac + `"
+				triggers = {
+					run = "first"
+				}
+			}
+		`
+	})
+
+	It("should send the request again when the triggers change", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_wake_on_lan."+resourceName, "id", "pub/ether-"+strings.ToLower(mac)),
+						resource.TestCheckResourceAttr("freebox_wake_on_lan."+resourceName, "wait_for_reachable", "false"),
+						resource.TestCheckResourceAttr("freebox_wake_on_lan."+resourceName, "triggers.run", "first"),
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("run", "second")(config),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("freebox_wake_on_lan."+resourceName, plancheck.ResourceActionReplace),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_wake_on_lan."+resourceName, "triggers.run", "second"),
+					),
+				},
+			},
+		})
+	})
+})
@@ -0,0 +1,513 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.Resource                = &wifiAccessPointResource{}
+	_ resource.ResourceWithImportState = &wifiAccessPointResource{}
+	_ resource.ResourceWithModifyPlan  = &wifiAccessPointResource{}
+)
+
+func NewWifiAccessPointResource() resource.Resource {
+	return &wifiAccessPointResource{}
+}
+
+// wifiAccessPointResource defines the resource implementation.
+type wifiAccessPointResource struct {
+	client client.Client
+}
+
+// wifiAccessPointModel describes the resource data model.
+type wifiAccessPointModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	State            types.String `tfsdk:"state"`
+	Band             types.String `tfsdk:"band"`
+	ChannelWidth     types.String `tfsdk:"channel_width"`
+	PrimaryChannel   types.Int64  `tfsdk:"primary_channel"`
+	SecondaryChannel types.Int64  `tfsdk:"secondary_channel"`
+	DFSEnabled       types.Bool   `tfsdk:"dfs_enabled"`
+	HT               types.Object `tfsdk:"ht"`
+	VHT              types.Object `tfsdk:"vht"`
+	HE               types.Object `tfsdk:"he"`
+}
+
+type wifiHTModel struct {
+	Enabled   types.Bool `tfsdk:"enabled"`
+	ShortGI20 types.Bool `tfsdk:"short_gi_20"`
+	ShortGI40 types.Bool `tfsdk:"short_gi_40"`
+}
+
+func (m wifiHTModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":     types.BoolType,
+		"short_gi_20": types.BoolType,
+		"short_gi_40": types.BoolType,
+	}
+}
+
+type wifiVHTModel struct {
+	Enabled    types.Bool `tfsdk:"enabled"`
+	ShortGI80  types.Bool `tfsdk:"short_gi_80"`
+	ShortGI160 types.Bool `tfsdk:"short_gi_160"`
+}
+
+func (m wifiVHTModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":      types.BoolType,
+		"short_gi_80":  types.BoolType,
+		"short_gi_160": types.BoolType,
+	}
+}
+
+type wifiHEModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (m wifiHEModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled": types.BoolType,
+	}
+}
+
+// toPayload applies the known values of the model on top of the current configuration of the access point.
+func (m *wifiAccessPointModel) toPayload(ctx context.Context, current freeboxTypes.WifiAccessPointConfig) (payload freeboxTypes.WifiAccessPointConfig, diagnostics diag.Diagnostics) {
+	payload = current
+
+	if !m.Band.IsNull() && !m.Band.IsUnknown() {
+		payload.Band = m.Band.ValueString()
+	}
+	if !m.ChannelWidth.IsNull() && !m.ChannelWidth.IsUnknown() {
+		payload.ChannelWidth = m.ChannelWidth.ValueString()
+	}
+	if !m.PrimaryChannel.IsNull() && !m.PrimaryChannel.IsUnknown() {
+		payload.PrimaryChannel = m.PrimaryChannel.ValueInt64()
+	}
+	if !m.SecondaryChannel.IsNull() && !m.SecondaryChannel.IsUnknown() {
+		payload.SecondaryChannel = m.SecondaryChannel.ValueInt64()
+	}
+	if !m.DFSEnabled.IsNull() && !m.DFSEnabled.IsUnknown() {
+		payload.DFSEnabled = m.DFSEnabled.ValueBool()
+	}
+
+	if !m.HT.IsNull() && !m.HT.IsUnknown() {
+		var ht wifiHTModel
+		if diags := m.HT.As(ctx, &ht, basetypes.ObjectAsOptions{}); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+		setIfKnown(&payload.HT.HTEnabled, ht.Enabled)
+		setIfKnown(&payload.HT.ShortGI20, ht.ShortGI20)
+		setIfKnown(&payload.HT.ShortGI40, ht.ShortGI40)
+	}
+
+	if !m.VHT.IsNull() && !m.VHT.IsUnknown() {
+		var vht wifiVHTModel
+		if diags := m.VHT.As(ctx, &vht, basetypes.ObjectAsOptions{}); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+		setIfKnown(&payload.HT.ACEnabled, vht.Enabled)
+		setIfKnown(&payload.HT.ShortGI80, vht.ShortGI80)
+		setIfKnown(&payload.HT.ShortGI160, vht.ShortGI160)
+	}
+
+	if !m.HE.IsNull() && !m.HE.IsUnknown() {
+		var he wifiHEModel
+		if diags := m.HE.As(ctx, &he, basetypes.ObjectAsOptions{}); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+		setIfKnown(&payload.HT.AXEnabled, he.Enabled)
+	}
+
+	return payload, nil
+}
+
+// setIfKnown sets target to the value of the attribute when it is known.
+func setIfKnown(target *bool, value types.Bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		*target = value.ValueBool()
+	}
+}
+
+func (m *wifiAccessPointModel) fromClientType(accessPoint freeboxTypes.WifiAccessPoint) {
+	m.ID = basetypes.NewInt64Value(accessPoint.ID)
+	m.Name = basetypes.NewStringValue(accessPoint.Name)
+	m.State = basetypes.NewStringValue(accessPoint.Status.State)
+	m.Band = basetypes.NewStringValue(accessPoint.Config.Band)
+	m.ChannelWidth = basetypes.NewStringValue(accessPoint.Config.ChannelWidth)
+	m.PrimaryChannel = basetypes.NewInt64Value(accessPoint.Config.PrimaryChannel)
+	m.SecondaryChannel = basetypes.NewInt64Value(accessPoint.Config.SecondaryChannel)
+	m.DFSEnabled = basetypes.NewBoolValue(accessPoint.Config.DFSEnabled)
+	m.HT = basetypes.NewObjectValueMust(wifiHTModel{}.AttrTypes(), map[string]attr.Value{
+		"enabled":     basetypes.NewBoolValue(accessPoint.Config.HT.HTEnabled),
+		"short_gi_20": basetypes.NewBoolValue(accessPoint.Config.HT.ShortGI20),
+		"short_gi_40": basetypes.NewBoolValue(accessPoint.Config.HT.ShortGI40),
+	})
+	m.VHT = basetypes.NewObjectValueMust(wifiVHTModel{}.AttrTypes(), map[string]attr.Value{
+		"enabled":      basetypes.NewBoolValue(accessPoint.Config.HT.ACEnabled),
+		"short_gi_80":  basetypes.NewBoolValue(accessPoint.Config.HT.ShortGI80),
+		"short_gi_160": basetypes.NewBoolValue(accessPoint.Config.HT.ShortGI160),
+	})
+	m.HE = basetypes.NewObjectValueMust(wifiHEModel{}.AttrTypes(), map[string]attr.Value{
+		"enabled": basetypes.NewBoolValue(accessPoint.Config.HT.AXEnabled),
+	})
+}
+
+func (v *wifiAccessPointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi_ap"
+}
+
+func (v *wifiAccessPointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the radio configuration of a Wi-Fi access point of the Freebox. The access points always exist on the Freebox, one per radio: attributes that are not set keep their current value, and destroying this resource leaves the radio as is. Channel combinations are checked at plan time against the ones allowed by the access point (see the `freebox_wifi_allowed_channels` data source).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the access point",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the access point (e.g. `2.4G`, `5G`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Current state of the access point (e.g. `active`, `scanning`, `disabled`)",
+				Computed:            true,
+			},
+			"band": schema.StringAttribute{
+				MarkdownDescription: "Frequency band of the access point (`2d4g`, `5g`, `6g` or `60g`)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("2d4g", "5g", "6g", "60g"),
+				},
+			},
+			"channel_width": schema.StringAttribute{
+				MarkdownDescription: "Channel width in MHz (`20`, `40`, `80`, `160` or `320`)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("20", "40", "80", "160", "320"),
+				},
+			},
+			"primary_channel": schema.Int64Attribute{
+				MarkdownDescription: "Primary channel of the access point, `0` for automatic selection",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"secondary_channel": schema.Int64Attribute{
+				MarkdownDescription: "Secondary channel of the access point, `0` for automatic selection or when not applicable",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"dfs_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether DFS (Dynamic Frequency Selection) channels can be used",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ht": schema.SingleNestedAttribute{
+				MarkdownDescription: "802.11n (HT) settings",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether 802.11n is enabled",
+						Optional:            true,
+						Computed:            true,
+					},
+					"short_gi_20": schema.BoolAttribute{
+						MarkdownDescription: "Whether the short guard interval is used on 20 MHz channels",
+						Optional:            true,
+						Computed:            true,
+					},
+					"short_gi_40": schema.BoolAttribute{
+						MarkdownDescription: "Whether the short guard interval is used on 40 MHz channels",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+			},
+			"vht": schema.SingleNestedAttribute{
+				MarkdownDescription: "802.11ac (VHT) settings",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether 802.11ac is enabled",
+						Optional:            true,
+						Computed:            true,
+					},
+					"short_gi_80": schema.BoolAttribute{
+						MarkdownDescription: "Whether the short guard interval is used on 80 MHz channels",
+						Optional:            true,
+						Computed:            true,
+					},
+					"short_gi_160": schema.BoolAttribute{
+						MarkdownDescription: "Whether the short guard interval is used on 160 MHz channels",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+			},
+			"he": schema.SingleNestedAttribute{
+				MarkdownDescription: "802.11ax (HE) settings",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether 802.11ax is enabled",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (v *wifiAccessPointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *wifiAccessPointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || v.client == nil {
+		return // Destroying or provider not configured yet
+	}
+
+	var plan wifiAccessPointModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ID.IsUnknown() || plan.Band.IsUnknown() || plan.ChannelWidth.IsUnknown() || plan.PrimaryChannel.IsUnknown() {
+		return // Can not be checked until the values are known
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state wifiAccessPointModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Band.Equal(state.Band) && plan.ChannelWidth.Equal(state.ChannelWidth) && plan.PrimaryChannel.Equal(state.PrimaryChannel) && plan.SecondaryChannel.Equal(state.SecondaryChannel) && plan.DFSEnabled.Equal(state.DFSEnabled) {
+			return // The channels are unchanged
+		}
+	}
+
+	combinations, err := v.client.ListWifiAllowedChannelCombinations(ctx, plan.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to list allowed Wi-Fi channel combinations",
+			fmt.Sprintf("Access point: %d, Error: %s", plan.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	band, width := plan.Band.ValueString(), plan.ChannelWidth.ValueString()
+	primary, secondary := plan.PrimaryChannel.ValueInt64(), plan.SecondaryChannel.ValueInt64() // An unknown secondary channel is selected automatically
+
+	matching := slices.IndexFunc(combinations, func(combination freeboxTypes.WifiChannelCombination) bool {
+		if combination.Band != band || combination.ChannelWidth != width {
+			return false
+		}
+		if primary == 0 {
+			return true // Automatic selection
+		}
+		if combination.PrimaryChannel != primary {
+			return false
+		}
+		return secondary == 0 || slices.Contains(combination.SecondaryChannels, secondary)
+	})
+	if matching < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary_channel"),
+			"Unsupported Wi-Fi channel combination",
+			fmt.Sprintf("Access point %d does not allow the %s MHz channel width on band %s with primary channel %d and secondary channel %d. Use the freebox_wifi_allowed_channels data source to list the allowed combinations.", plan.ID.ValueInt64(), width, band, primary, secondary),
+		)
+		return
+	}
+
+	if primary != 0 && combinations[matching].NeedDFS && !plan.DFSEnabled.IsUnknown() && !plan.DFSEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dfs_enabled"),
+			"DFS required",
+			fmt.Sprintf("Primary channel %d of band %s requires DFS to be enabled", primary, band),
+		)
+	}
+}
+
+func (v *wifiAccessPointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model wifiAccessPointModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiAccessPointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model wifiAccessPointModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessPoint, err := v.client.GetWifiAccessPoint(ctx, model.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read Wi-Fi access point",
+			fmt.Sprintf("Failed to read Wi-Fi access point %d: %s", model.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	model.fromClientType(accessPoint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiAccessPointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model wifiAccessPointModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiAccessPointResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The radios of the Freebox cannot be deleted; this is a no-op.
+}
+
+func (v *wifiAccessPointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected the import identifier to be an int64 but got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// apply merges the planned configuration into the current one of the access point and pushes it to the Freebox.
+func (v *wifiAccessPointResource) apply(ctx context.Context, model *wifiAccessPointModel) (diagnostics diag.Diagnostics) {
+	current, err := v.client.GetWifiAccessPoint(ctx, model.ID.ValueInt64())
+	if err != nil {
+		diagnostics.AddError("Failed to read Wi-Fi access point", fmt.Sprintf("Failed to read Wi-Fi access point %d: %s", model.ID.ValueInt64(), err))
+		return
+	}
+
+	payload, diags := model.toPayload(ctx, current.Config)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	accessPoint, err := v.client.UpdateWifiAccessPoint(ctx, model.ID.ValueInt64(), payload)
+	if err != nil {
+		diagnostics.AddError("Failed to update Wi-Fi access point", fmt.Sprintf("Failed to update Wi-Fi access point %d: %s", model.ID.ValueInt64(), err))
+		return
+	}
+
+	model.fromClientType(accessPoint)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package internal_test
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_wifi_ap" { ... }`, func() {
+	var (
+		resName    string
+		config     string
+		originalAP freeboxTypes.WifiAccessPoint
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		originalAP, err = freeboxClient.GetWifiAccessPoint(ctx, 0)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateWifiAccessPoint(ctx, 0, originalAP.Config)
+			Expect(err).To(BeNil(), "failed to restore original Wi-Fi access point config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_wifi_ap" "` + resName + `" {
+				id              = 0
+				band            = "` + originalAP.Config.Band + `"
+				channel_width   = "` + originalAP.Config.ChannelWidth + `"
+				primary_channel = 0
+			}
+		`
+	})
+
+	It("should configure and import the access point", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_wifi_ap."+resName, "id", "0"),
+						resource.TestCheckResourceAttr("freebox_wifi_ap."+resName, "name", originalAP.Name),
+						resource.TestCheckResourceAttr("freebox_wifi_ap."+resName, "band", originalAP.Config.Band),
+						resource.TestCheckResourceAttr("freebox_wifi_ap."+resName, "primary_channel", "0"),
+						func(s *terraform.State) error {
+							accessPoint, err := freeboxClient.GetWifiAccessPoint(ctx, 0)
+							Expect(err).To(BeNil())
+							Expect(accessPoint.Config.PrimaryChannel).To(BeZero())
+							return nil
+						},
+					),
+				},
+				{
+					Config:            config,
+					ResourceName:      "freebox_wifi_ap." + resName,
+					ImportState:       true,
+					ImportStateId:     "0",
+					ImportStateVerify: true,
+					ImportStateVerifyIgnore: []string{
+						"state",
+					},
+				},
+			},
+		})
+	})
+
+	Context("with a channel that is not allowed", func() {
+		It("should fail at plan time", func(ctx SpecContext) {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      terraformConfigWithAttribute("primary_channel", 999)(config),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(`Unsupported Wi-Fi channel combination`),
+					},
+				},
+			})
+		})
+	})
+})
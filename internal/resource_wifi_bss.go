@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.Resource                = &wifiBSSResource{}
+	_ resource.ResourceWithImportState = &wifiBSSResource{}
+)
+
+// wifiBSSEncryptions lists the WPA encryption modes accepted for a BSS.
+var wifiBSSEncryptions = []string{
+	"wpa2_psk_auto",
+	"wpa2_psk_ccmp",
+	"wpa12_psk_auto",
+	"wpa23_psk_ccmp",
+	"wpa3_sae_ccmp",
+}
+
+func NewWifiBSSResource() resource.Resource {
+	return &wifiBSSResource{}
+}
+
+// wifiBSSResource defines the resource implementation.
+type wifiBSSResource struct {
+	client client.Client
+}
+
+// wifiBSSModel describes the resource data model.
+type wifiBSSModel struct {
+	ID             types.String `tfsdk:"id"`
+	PhyID          types.Int64  `tfsdk:"phy_id"`
+	State          types.String `tfsdk:"state"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	SSID           types.String `tfsdk:"ssid"`
+	HideSSID       types.Bool   `tfsdk:"hide_ssid"`
+	Encryption     types.String `tfsdk:"encryption"`
+	Key            types.String `tfsdk:"key"`
+	KeyVersion     types.Int64  `tfsdk:"key_version"`
+	EapolVersion   types.Int64  `tfsdk:"eapol_version"`
+	MacFilterState types.String `tfsdk:"mac_filter_state"`
+}
+
+// toPayload applies the values of the model on top of the current configuration of the BSS.
+func (m *wifiBSSModel) toPayload(current freeboxTypes.WifiBSSConfig) freeboxTypes.WifiBSSConfig {
+	payload := current
+	payload.Enabled = m.Enabled.ValueBool()
+	payload.SSID = m.SSID.ValueString()
+	payload.HideSSID = m.HideSSID.ValueBool()
+	payload.Encryption = m.Encryption.ValueString()
+
+	// The key is write-only, it is only known when read from the configuration
+	if !m.Key.IsNull() && !m.Key.IsUnknown() {
+		payload.Key = m.Key.ValueString()
+	}
+	if !m.EapolVersion.IsNull() && !m.EapolVersion.IsUnknown() {
+		payload.EapolVersion = m.EapolVersion.ValueInt64()
+	}
+	if !m.MacFilterState.IsNull() && !m.MacFilterState.IsUnknown() {
+		payload.MacFilterState = m.MacFilterState.ValueString()
+	}
+
+	return payload
+}
+
+func (m *wifiBSSModel) fromClientType(bss freeboxTypes.WifiBSS) {
+	m.ID = basetypes.NewStringValue(bss.ID)
+	m.PhyID = basetypes.NewInt64Value(bss.PhyID)
+	m.State = basetypes.NewStringValue(bss.Status.State)
+	m.Enabled = basetypes.NewBoolValue(bss.Config.Enabled)
+	m.SSID = basetypes.NewStringValue(bss.Config.SSID)
+	m.HideSSID = basetypes.NewBoolValue(bss.Config.HideSSID)
+	m.Encryption = basetypes.NewStringValue(bss.Config.Encryption)
+	m.EapolVersion = basetypes.NewInt64Value(bss.Config.EapolVersion)
+	m.MacFilterState = basetypes.NewStringValue(bss.Config.MacFilterState)
+}
+
+func (v *wifiBSSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi_bss"
+}
+
+func (v *wifiBSSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a BSS (the network advertised under an SSID) of a Wi-Fi access point of the Freebox. The BSS always exist on the Freebox, one per access point: destroying this resource disables the BSS.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the BSS, which is its BSSID (e.g. `00:11:22:33:44:55`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"phy_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the access point the BSS belongs to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Current state of the BSS (e.g. `active`, `disabled`)",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the BSS is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ssid": schema.StringAttribute{
+				MarkdownDescription: "SSID of the network",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"hide_ssid": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SSID is hidden",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"encryption": schema.StringAttribute{
+				MarkdownDescription: "Encryption of the network: `wpa2_psk_auto`, `wpa2_psk_ccmp` (WPA2), `wpa3_sae_ccmp` (WPA3), `wpa12_psk_auto` (WPA/WPA2 mixed) or `wpa23_psk_ccmp` (WPA2/WPA3 mixed)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(wifiBSSEncryptions...),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "WPA key of the network. It is write-only: sent to the Freebox but never stored in the Terraform state, which requires Terraform 1.11 or later. Changing it alone is not detected, bump `key_version` to push a new key",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 63),
+				},
+			},
+			"key_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the key, to change whenever `key` changes so that the new key is pushed to the Freebox",
+				Optional:            true,
+			},
+			"eapol_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the EAPOL protocol (`1` or `2`). If not set, the current version is kept",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2),
+				},
+			},
+			"mac_filter_state": schema.StringAttribute{
+				MarkdownDescription: "MAC filtering of the BSS (`disabled`, `whitelist` or `blacklist`). If not set, the current state is kept",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("disabled", "whitelist", "blacklist"),
+				},
+			},
+		},
+	}
+}
+
+func (v *wifiBSSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *wifiBSSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model wifiBSSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &model.Key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiBSSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model wifiBSSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bss, err := v.client.GetWifiBSS(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read Wi-Fi BSS",
+			fmt.Sprintf("Failed to read Wi-Fi BSS %q: %s", model.ID.ValueString(), err),
+		)
+		return
+	}
+
+	model.fromClientType(bss)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiBSSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model wifiBSSModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &model.Key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiBSSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model wifiBSSModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Enabled = basetypes.NewBoolValue(false)
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+}
+
+func (v *wifiBSSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// apply merges the planned configuration into the current one of the BSS and pushes it to the Freebox.
+func (v *wifiBSSResource) apply(ctx context.Context, model *wifiBSSModel) (diagnostics diag.Diagnostics) {
+	current, err := v.client.GetWifiBSS(ctx, model.ID.ValueString())
+	if err != nil {
+		diagnostics.AddError("Failed to read Wi-Fi BSS", fmt.Sprintf("Failed to read Wi-Fi BSS %q: %s", model.ID.ValueString(), err))
+		return
+	}
+
+	bss, err := v.client.UpdateWifiBSS(ctx, model.ID.ValueString(), model.toPayload(current.Config))
+	if err != nil {
+		diagnostics.AddError("Failed to update Wi-Fi BSS", fmt.Sprintf("Failed to update Wi-Fi BSS %q: %s", model.ID.ValueString(), err))
+		return
+	}
+
+	model.fromClientType(bss)
+
+	return nil
+}
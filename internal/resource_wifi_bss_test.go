@@ -0,0 +1,91 @@
+package internal_test
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_wifi_bss" { ... }`, func() {
+	var (
+		resName     string
+		config      string
+		originalBSS freeboxTypes.WifiBSS
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		bssList, err := freeboxClient.ListWifiBSS(ctx)
+		Expect(err).To(BeNil())
+		Expect(bssList).ToNot(BeEmpty())
+		originalBSS = bssList[0]
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateWifiBSS(ctx, originalBSS.ID, originalBSS.Config)
+			Expect(err).To(BeNil(), "failed to restore original Wi-Fi BSS config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		// The current SSID and key are kept to avoid disconnecting the clients of the network while testing
+		config = providerBlock + `
+			resource "freebox_wifi_bss" "` + resName + `" {
+				id          = "` + originalBSS.ID + `"
+				enabled     = ` + strconv.FormatBool(originalBSS.Config.Enabled) + `
+				ssid        = "` + originalBSS.Config.SSID + `"
+				hide_ssid   = ` + strconv.FormatBool(originalBSS.Config.HideSSID) + `
+				encryption  = "` + originalBSS.Config.Encryption + `"
+				key         = "` + originalBSS.Config.Key + `"
+				key_version = 1
+			}
+		`
+	})
+
+	It("should configure, update and import the BSS", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_wifi_bss."+resName, "id", originalBSS.ID),
+						resource.TestCheckResourceAttr("freebox_wifi_bss."+resName, "phy_id", strconv.FormatInt(originalBSS.PhyID, 10)),
+						resource.TestCheckResourceAttr("freebox_wifi_bss."+resName, "ssid", originalBSS.Config.SSID),
+						resource.TestCheckResourceAttr("freebox_wifi_bss."+resName, "encryption", originalBSS.Config.Encryption),
+						resource.TestCheckResourceAttr("freebox_wifi_bss."+resName, "key_version", "1"),
+						resource.TestCheckNoResourceAttr("freebox_wifi_bss."+resName, "key"),
+					),
+				},
+				{
+					Config: terraformConfigWithAttribute("hide_ssid", !originalBSS.Config.HideSSID)(config),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_wifi_bss."+resName, "hide_ssid", strconv.FormatBool(!originalBSS.Config.HideSSID)),
+						func(s *terraform.State) error {
+							bss, err := freeboxClient.GetWifiBSS(ctx, originalBSS.ID)
+							Expect(err).To(BeNil())
+							Expect(bss.Config.HideSSID).To(Equal(!originalBSS.Config.HideSSID))
+							Expect(bss.Config.SSID).To(Equal(originalBSS.Config.SSID))
+							return nil
+						},
+					),
+				},
+				{
+					Config:                  terraformConfigWithAttribute("hide_ssid", !originalBSS.Config.HideSSID)(config),
+					ResourceName:            "freebox_wifi_bss." + resName,
+					ImportState:             true,
+					ImportStateId:           originalBSS.ID,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"state", "key_version"},
+				},
+			},
+		})
+	})
+})
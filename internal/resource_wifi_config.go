@@ -0,0 +1,312 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	"github.com/nikolalohinski/terraform-provider-freebox/internal/models"
+)
+
+var (
+	_ resource.ResourceWithImportState = &wifiConfigResource{}
+)
+
+func NewWifiConfigResource() resource.Resource {
+	return &wifiConfigResource{}
+}
+
+type wifiConfigResource struct {
+	client client.Client
+}
+
+type wifiConfigModel struct {
+	ID       types.String `tfsdk:"id"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Planning types.Object `tfsdk:"planning"`
+}
+
+type wifiPlanningModel struct {
+	Enabled   types.Bool `tfsdk:"enabled"`
+	OffRanges types.List `tfsdk:"off_ranges"`
+}
+
+func (m wifiPlanningModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":    types.BoolType,
+		"off_ranges": types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(models.WifiPlanningRangeModel{}.AttrTypes())),
+	}
+}
+
+// toPayload applies the values of the model on top of the current Wi-Fi configuration.
+func (m *wifiConfigModel) toPayload(current freeboxTypes.WifiConfig) freeboxTypes.WifiConfig {
+	payload := current
+	payload.Enabled = m.Enabled.ValueBool()
+	return payload
+}
+
+func (m *wifiConfigModel) fromClientType(config freeboxTypes.WifiConfig) {
+	m.ID = basetypes.NewStringValue("wifi")
+	m.Enabled = basetypes.NewBoolValue(config.Enabled)
+}
+
+// fromPlanning sets the planning from the Wi-Fi planning of the Freebox. The current time
+// ranges are kept as is when they are equivalent to the planning, so that the way they are
+// written in the configuration does not produce any drift.
+func (m *wifiConfigModel) fromPlanning(ctx context.Context, planning freeboxTypes.WifiPlanning) (diagnostics diag.Diagnostics) {
+	offRanges, diags := models.WifiPlanningFromMapping(planning.Mapping, int(planning.Resolution))
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	if !m.Planning.IsNull() && !m.Planning.IsUnknown() {
+		var current wifiPlanningModel
+		if diags := m.Planning.As(ctx, &current, basetypes.ObjectAsOptions{}); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+
+		mapping, diags := models.WifiPlanningToMapping(ctx, current.OffRanges, int(planning.Resolution))
+		if !diags.HasError() && slices.Equal(mapping, planning.Mapping) {
+			offRanges = current.OffRanges
+		}
+	}
+
+	m.Planning = basetypes.NewObjectValueMust(wifiPlanningModel{}.AttrTypes(), map[string]attr.Value{
+		"enabled":    basetypes.NewBoolValue(planning.UsePlanning),
+		"off_ranges": offRanges,
+	})
+
+	return nil
+}
+
+func (v *wifiConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi_config"
+}
+
+func (v *wifiConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the global Wi-Fi configuration of the Freebox and its power-saving planning. This is a singleton resource: only one Wi-Fi configuration exists per Freebox. Destroying this resource turns the Wi-Fi back on and disables the planning.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier for the singleton Wi-Fi configuration resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Wi-Fi is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"planning": schema.SingleNestedAttribute{
+				MarkdownDescription: "Power-saving planning of the Wi-Fi. If not set, the current planning is kept",
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the Wi-Fi is turned off during the `off_ranges`",
+						Required:            true,
+					},
+					"off_ranges": schema.ListNestedAttribute{
+						MarkdownDescription: "Weekly time ranges during which the Wi-Fi is turned off. The ranges must be aligned on the slots of the Freebox planning (30 minutes on most models)",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: models.WifiPlanningRangeModel{}.ResourceAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (v *wifiConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *wifiConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model wifiConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model wifiConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := v.client.GetWifiConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read Wi-Fi configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(config)
+
+	planning, err := v.client.GetWifiPlanning(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read Wi-Fi planning",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(model.fromPlanning(ctx, planning)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model wifiConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	config, err := v.client.GetWifiConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read Wi-Fi configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	config.Enabled = true
+
+	if _, err := v.client.UpdateWifiConfig(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to enable Wi-Fi",
+			err.Error(),
+		)
+		return
+	}
+
+	planning, err := v.client.GetWifiPlanning(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read Wi-Fi planning",
+			err.Error(),
+		)
+		return
+	}
+
+	planning.UsePlanning = false
+
+	if _, err := v.client.UpdateWifiPlanning(ctx, planning); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable Wi-Fi planning",
+			err.Error(),
+		)
+	}
+}
+
+func (v *wifiConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "wifi")...)
+}
+
+// apply pushes the Wi-Fi configuration and, when set, the planning to the Freebox.
+func (v *wifiConfigResource) apply(ctx context.Context, model *wifiConfigModel) (diagnostics diag.Diagnostics) {
+	current, err := v.client.GetWifiConfig(ctx)
+	if err != nil {
+		diagnostics.AddError("Failed to read Wi-Fi configuration", err.Error())
+		return
+	}
+
+	config, err := v.client.UpdateWifiConfig(ctx, model.toPayload(current))
+	if err != nil {
+		diagnostics.AddError("Failed to update Wi-Fi configuration", err.Error())
+		return
+	}
+
+	model.fromClientType(config)
+
+	planning, err := v.client.GetWifiPlanning(ctx)
+	if err != nil {
+		diagnostics.AddError("Failed to read Wi-Fi planning", err.Error())
+		return
+	}
+
+	if !model.Planning.IsNull() && !model.Planning.IsUnknown() {
+		var planningModel wifiPlanningModel
+		if diags := model.Planning.As(ctx, &planningModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+
+		mapping, diags := models.WifiPlanningToMapping(ctx, planningModel.OffRanges, int(planning.Resolution))
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+
+		planning.UsePlanning = planningModel.Enabled.ValueBool()
+		planning.Mapping = mapping
+
+		if planning, err = v.client.UpdateWifiPlanning(ctx, planning); err != nil {
+			diagnostics.AddError("Failed to update Wi-Fi planning", err.Error())
+			return
+		}
+	}
+
+	return model.fromPlanning(ctx, planning)
+}
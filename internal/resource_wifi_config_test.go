@@ -0,0 +1,102 @@
+package internal_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_wifi_config" { ... }`, func() {
+	var (
+		resName          string
+		config           string
+		originalConfig   freeboxTypes.WifiConfig
+		originalPlanning freeboxTypes.WifiPlanning
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		var err error
+		originalConfig, err = freeboxClient.GetWifiConfig(ctx)
+		Expect(err).To(BeNil())
+		originalPlanning, err = freeboxClient.GetWifiPlanning(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			_, err := freeboxClient.UpdateWifiConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original Wi-Fi config")
+			_, err = freeboxClient.UpdateWifiPlanning(ctx, originalPlanning)
+			Expect(err).To(BeNil(), "failed to restore original Wi-Fi planning")
+		})
+	})
+
+	JustBeforeEach(func() {
+		// The planning is kept disabled to avoid turning the Wi-Fi off while testing
+		config = providerBlock + `
+			resource "freebox_wifi_config" "` + resName + `" {
+				enabled  = true
+				planning = {
+					enabled    = false
+					off_ranges = [
+						{
+							days  = ["saturday", "sunday"]
+							start = "02:00"
+							end   = "06:30"
+						},
+					]
+				}
+			}
+		`
+	})
+
+	It("should configure, import and reset the Wi-Fi configuration", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_wifi_config."+resName, "id", "wifi"),
+						resource.TestCheckResourceAttr("freebox_wifi_config."+resName, "enabled", "true"),
+						resource.TestCheckResourceAttr("freebox_wifi_config."+resName, "planning.enabled", "false"),
+						resource.TestCheckResourceAttr("freebox_wifi_config."+resName, "planning.off_ranges.#", "1"),
+						func(s *terraform.State) error {
+							planning, err := freeboxClient.GetWifiPlanning(ctx)
+							Expect(err).To(BeNil())
+							Expect(planning.UsePlanning).To(BeFalse())
+							slotsPerHour := int(planning.Resolution) / 24
+							Expect(planning.Mapping[5*int(planning.Resolution)+3*slotsPerHour]).To(Equal("off")) // Saturday 03:00
+							Expect(planning.Mapping[6*int(planning.Resolution)+6*slotsPerHour]).To(Equal("off")) // Sunday 06:00
+							Expect(planning.Mapping[6*int(planning.Resolution)+7*slotsPerHour]).To(Equal("on"))  // Sunday 07:00
+							Expect(planning.Mapping[3*slotsPerHour]).To(Equal("on"))                             // Monday 03:00
+							return nil
+						},
+					),
+				},
+				{
+					Config:            config,
+					ResourceName:      "freebox_wifi_config." + resName,
+					ImportState:       true,
+					ImportStateId:     "wifi",
+					ImportStateVerify: true,
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetWifiConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(config.Enabled).To(BeTrue())
+				planning, err := freeboxClient.GetWifiPlanning(ctx)
+				Expect(err).To(BeNil())
+				Expect(planning.UsePlanning).To(BeFalse())
+				return nil
+			},
+		})
+	})
+})
@@ -0,0 +1,383 @@
+package internal
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.Resource                = &wifiGuestKeyResource{}
+	_ resource.ResourceWithImportState = &wifiGuestKeyResource{}
+)
+
+const (
+	// wifiGuestKeyLength is the length of the keys generated when none is given.
+	wifiGuestKeyLength = 16
+	// wifiGuestKeyAlphabet excludes the characters that are easily mistaken for one another.
+	wifiGuestKeyAlphabet = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+func NewWifiGuestKeyResource() resource.Resource {
+	return &wifiGuestKeyResource{}
+}
+
+// wifiGuestKeyResource defines the resource implementation.
+type wifiGuestKeyResource struct {
+	client client.Client
+}
+
+// wifiGuestKeyModel describes the resource data model.
+type wifiGuestKeyModel struct {
+	ID            types.Int64          `tfsdk:"id"`
+	Description   types.String         `tfsdk:"description"`
+	Key           types.String         `tfsdk:"key"`
+	Duration      timetypes.GoDuration `tfsdk:"duration"`
+	MaxUseCount   types.Int64          `tfsdk:"max_use_count"`
+	AccessType    types.String         `tfsdk:"access_type"`
+	ExpiresAt     timetypes.RFC3339    `tfsdk:"expires_at"`
+	SSID          types.String         `tfsdk:"ssid"`
+	QRCodePayload types.String         `tfsdk:"qr_code_payload"`
+}
+
+func (m *wifiGuestKeyModel) toPayload() (payload freeboxTypes.WifiCustomKeyPayload, diagnostics diag.Diagnostics) {
+	payload = freeboxTypes.WifiCustomKeyPayload{
+		Description: m.Description.ValueString(),
+		Key:         m.Key.ValueString(),
+		MaxUseCount: m.MaxUseCount.ValueInt64(),
+		AccessType:  m.AccessType.ValueString(),
+	}
+
+	if !m.Duration.IsNull() && !m.Duration.IsUnknown() {
+		duration, diags := m.Duration.ValueGoDuration()
+		if diags.HasError() {
+			diagnostics.Append(diags...)
+			return
+		}
+		payload.Duration = int64(duration.Seconds())
+	}
+
+	return payload, nil
+}
+
+func (m *wifiGuestKeyModel) fromClientType(key freeboxTypes.WifiCustomKey) {
+	m.ID = basetypes.NewInt64Value(key.ID)
+	m.Description = basetypes.NewStringValue(key.Params.Description)
+	m.Key = basetypes.NewStringValue(key.Params.Key)
+	m.MaxUseCount = basetypes.NewInt64Value(key.Params.MaxUseCount)
+	m.AccessType = basetypes.NewStringValue(key.Params.AccessType)
+}
+
+// wifiQRCodePayload builds the payload of the QR code used by phones to join a WPA network.
+func wifiQRCodePayload(ssid, key string) string {
+	escape := strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, `:`, `\:`, `"`, `\"`)
+	return "WIFI:T:WPA;S:" + escape.Replace(ssid) + ";P:" + escape.Replace(key) + ";;"
+}
+
+// generateWifiGuestKey returns a random key made of wifiGuestKeyLength characters of wifiGuestKeyAlphabet.
+func generateWifiGuestKey() (string, error) {
+	var builder strings.Builder
+	for i := 0; i < wifiGuestKeyLength; i++ {
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(wifiGuestKeyAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		builder.WriteByte(wifiGuestKeyAlphabet[index.Int64()])
+	}
+	return builder.String(), nil
+}
+
+func (v *wifiGuestKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi_guest_key"
+}
+
+func (v *wifiGuestKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a guest access key of the Wi-Fi network of the Freebox: an additional temporary WPA key to join the main network. Guest keys can not be updated: changing any argument creates a new key. A key that expired is removed from the Freebox and gets recreated on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique identifier of the guest key",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the guest key",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "WPA key given to the guests. If not set, a random key is generated",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 63),
+				},
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "Validity of the guest key from its creation (e.g. `168h`). If not set, the key never expires",
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_use_count": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of devices that can use the guest key, `0` for no limit",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"access_type": schema.StringAttribute{
+				MarkdownDescription: "Access granted to the guests: `full` for the local network and Internet, `net_only` for Internet only",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("full"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("full", "net_only"),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiration date of the guest key, null if it never expires",
+				Computed:            true,
+				CustomType:          timetypes.RFC3339Type{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssid": schema.StringAttribute{
+				MarkdownDescription: "SSID of the network the guest key gives access to. The Freebox does not report it, so it is assumed to be the SSID of the first enabled BSS. Null when no BSS is enabled",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"qr_code_payload": schema.StringAttribute{
+				MarkdownDescription: "Payload of a QR code to join the network with the guest key (`WIFI:T:WPA;S:<ssid>;P:<key>;;`), null when the SSID is unknown",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (v *wifiGuestKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *wifiGuestKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model wifiGuestKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Key.IsNull() || model.Key.IsUnknown() {
+		key, err := generateWifiGuestKey()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to generate guest key",
+				err.Error(),
+			)
+			return
+		}
+		model.Key = basetypes.NewStringValue(key)
+	}
+
+	payload, diags := model.toPayload()
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	guestKey, err := v.client.CreateWifiCustomKey(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create guest key",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(guestKey)
+
+	if payload.Duration > 0 {
+		model.ExpiresAt = timetypes.NewRFC3339TimeValue(time.Now().Add(time.Duration(guestKey.Remaining) * time.Second).UTC().Truncate(time.Second))
+	} else {
+		model.ExpiresAt = timetypes.NewRFC3339Null()
+	}
+
+	resp.Diagnostics.Append(v.setNetwork(ctx, &model)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiGuestKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model wifiGuestKeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guestKey, err := v.client.GetWifiCustomKey(ctx, model.ID.ValueInt64())
+	if err != nil {
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+			resp.State.RemoveResource(ctx) // Expired or deleted
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to get guest key",
+			err.Error(),
+		)
+		return
+	}
+
+	model.fromClientType(guestKey)
+
+	if model.ExpiresAt.IsNull() && guestKey.Params.Duration > 0 {
+		// Imported key: the expiration date is derived from the remaining validity
+		model.ExpiresAt = timetypes.NewRFC3339TimeValue(time.Now().Add(time.Duration(guestKey.Remaining) * time.Second).UTC().Truncate(time.Second))
+		model.Duration = timetypes.NewGoDurationValue(time.Duration(guestKey.Params.Duration) * time.Second)
+	}
+
+	if model.SSID.IsNull() {
+		resp.Diagnostics.Append(v.setNetwork(ctx, &model)...)
+	} else {
+		model.QRCodePayload = basetypes.NewStringValue(wifiQRCodePayload(model.SSID.ValueString(), model.Key.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiGuestKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model wifiGuestKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every argument requires a replacement: there is nothing to update on the Freebox.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiGuestKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model wifiGuestKeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := v.client.DeleteWifiCustomKey(ctx, model.ID.ValueInt64()); err != nil {
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == "noent" {
+			return // Already expired
+		}
+
+		resp.Diagnostics.AddError(
+			"Failed to delete guest key",
+			err.Error(),
+		)
+	}
+}
+
+func (v *wifiGuestKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected the import identifier to be an int64 but got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// setNetwork sets the SSID the guest key gives access to, and the QR code payload. The Freebox does not tell
+// which network a guest key belongs to, so this is a guess: the SSID of the first enabled BSS. Both are left
+// null with a warning when it can not be found, since the guest key itself is usable.
+func (v *wifiGuestKeyResource) setNetwork(ctx context.Context, model *wifiGuestKeyModel) (diagnostics diag.Diagnostics) {
+	model.SSID = basetypes.NewStringNull()
+	model.QRCodePayload = basetypes.NewStringNull()
+
+	bssList, err := v.client.ListWifiBSS(ctx)
+	if err != nil {
+		diagnostics.AddWarning("Failed to list Wi-Fi BSS", fmt.Sprintf("The SSID and the QR code payload of the guest key are not set: %s", err))
+		return
+	}
+
+	for _, bss := range bssList {
+		if bss.Config.Enabled {
+			model.SSID = basetypes.NewStringValue(bss.Config.SSID)
+			model.QRCodePayload = basetypes.NewStringValue(wifiQRCodePayload(bss.Config.SSID, model.Key.ValueString()))
+			return nil
+		}
+	}
+
+	diagnostics.AddWarning("No Wi-Fi network enabled", "Could not find any enabled BSS, the SSID and the QR code payload of the guest key are not set")
+
+	return
+}
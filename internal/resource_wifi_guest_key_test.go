@@ -0,0 +1,92 @@
+package internal_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nikolalohinski/free-go/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_wifi_guest_key" { ... }`, func() {
+	var (
+		resName string
+		config  string
+	)
+
+	BeforeEach(func() {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+	})
+
+	JustBeforeEach(func() {
+		config = providerBlock + `
+			resource "freebox_wifi_guest_key" "` + resName + `" {
+				description   = "` + resName + `"
+				duration      = "1h"
+				max_use_count = 1
+				access_type   = "net_only"
+			}
+		`
+	})
+
+	It("should create, import and delete a guest key", func(ctx SpecContext) {
+		var guestKeyID int64
+
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_wifi_guest_key."+resName, "description", resName),
+						resource.TestCheckResourceAttr("freebox_wifi_guest_key."+resName, "access_type", "net_only"),
+						resource.TestCheckResourceAttrSet("freebox_wifi_guest_key."+resName, "expires_at"),
+						resource.TestCheckResourceAttrSet("freebox_wifi_guest_key."+resName, "ssid"),
+						func(s *terraform.State) error {
+							attrs := s.RootModule().Resources["freebox_wifi_guest_key."+resName].Primary.Attributes
+
+							id, err := strconv.ParseInt(attrs["id"], 10, 64)
+							Expect(err).To(BeNil())
+							guestKeyID = id
+
+							Expect(attrs["key"]).To(HaveLen(16))
+							Expect(attrs["qr_code_payload"]).To(HavePrefix("WIFI:T:WPA;S:"))
+							Expect(attrs["qr_code_payload"]).To(HaveSuffix(";P:" + attrs["key"] + ";;"))
+
+							guestKey, err := freeboxClient.GetWifiCustomKey(ctx, id)
+							Expect(err).To(BeNil())
+							Expect(guestKey.Params.Description).To(Equal(resName))
+							Expect(guestKey.Params.Key).To(Equal(attrs["key"]))
+							Expect(guestKey.Params.MaxUseCount).To(Equal(int64(1)))
+							Expect(guestKey.Params.Duration).To(Equal(int64(3600)))
+							return nil
+						},
+					),
+				},
+				{
+					Config:            config,
+					ResourceName:      "freebox_wifi_guest_key." + resName,
+					ImportState:       true,
+					ImportStateIdFunc: func(s *terraform.State) (string, error) { return strconv.FormatInt(guestKeyID, 10), nil },
+					ImportStateVerify: true,
+					ImportStateVerifyIgnore: []string{
+						"expires_at", // Derived from the remaining validity when importing
+					},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				_, err := freeboxClient.GetWifiCustomKey(ctx, guestKeyID)
+				var apiErr *client.APIError
+				Expect(errors.As(err, &apiErr)).To(BeTrue())
+				Expect(apiErr.Code).To(Equal("noent"))
+				return nil
+			},
+		})
+	})
+})
@@ -0,0 +1,357 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/nikolalohinski/free-go/client"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+)
+
+var (
+	_ resource.Resource                = &wifiMacFilterResource{}
+	_ resource.ResourceWithImportState = &wifiMacFilterResource{}
+)
+
+const (
+	wifiMacFilterDisabled  = "disabled"
+	wifiMacFilterWhitelist = "whitelist"
+	wifiMacFilterBlacklist = "blacklist"
+)
+
+func NewWifiMacFilterResource() resource.Resource {
+	return &wifiMacFilterResource{}
+}
+
+// wifiMacFilterResource defines the resource implementation.
+type wifiMacFilterResource struct {
+	client client.Client
+}
+
+// wifiMacFilterModel describes the resource data model.
+type wifiMacFilterModel struct {
+	ID      types.String `tfsdk:"id"`
+	Mode    types.String `tfsdk:"mode"`
+	Entries types.Set    `tfsdk:"entries"`
+}
+
+type wifiMacFilterEntryModel struct {
+	Mac     types.String `tfsdk:"mac"`
+	Type    types.String `tfsdk:"type"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (m wifiMacFilterEntryModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"mac":     types.StringType,
+		"type":    types.StringType,
+		"comment": types.StringType,
+	}
+}
+
+// matches returns true if the entry targets the same MAC address for the same list as the filter.
+func (m wifiMacFilterEntryModel) matches(filter freeboxTypes.WifiMacFilter) bool {
+	return strings.EqualFold(m.Mac.ValueString(), filter.Mac) && m.Type.ValueString() == filter.Type
+}
+
+func (m wifiMacFilterEntryModel) toPayload() freeboxTypes.WifiMacFilterPayload {
+	return freeboxTypes.WifiMacFilterPayload{
+		Mac:     m.Mac.ValueString(),
+		Type:    m.Type.ValueString(),
+		Comment: m.Comment.ValueString(),
+	}
+}
+
+func (m *wifiMacFilterModel) entries(ctx context.Context) (entries []wifiMacFilterEntryModel, diagnostics diag.Diagnostics) {
+	if m.Entries.IsNull() || m.Entries.IsUnknown() {
+		return nil, nil
+	}
+
+	diagnostics.Append(m.Entries.ElementsAs(ctx, &entries, false)...)
+
+	return entries, diagnostics
+}
+
+// fromClientType sets the entries from the filters of the Freebox, keeping the spelling of the
+// MAC addresses of the current entries to avoid any drift on the case.
+func (m *wifiMacFilterModel) fromClientType(ctx context.Context, config freeboxTypes.WifiConfig, filters []freeboxTypes.WifiMacFilter) (diagnostics diag.Diagnostics) {
+	current, diags := m.entries(ctx)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	m.ID = basetypes.NewStringValue("mac_filter")
+	m.Mode = basetypes.NewStringValue(config.MacFilterState)
+
+	elements := make([]attr.Value, len(filters))
+	for i, filter := range filters {
+		mac := filter.Mac
+		for _, entry := range current {
+			if entry.matches(filter) {
+				mac = entry.Mac.ValueString()
+				break
+			}
+		}
+
+		comment := basetypes.NewStringNull()
+		if filter.Comment != "" {
+			comment = basetypes.NewStringValue(filter.Comment)
+		}
+
+		elements[i] = basetypes.NewObjectValueMust(wifiMacFilterEntryModel{}.AttrTypes(), map[string]attr.Value{
+			"mac":     basetypes.NewStringValue(mac),
+			"type":    basetypes.NewStringValue(filter.Type),
+			"comment": comment,
+		})
+	}
+
+	if len(elements) == 0 && m.Entries.IsNull() {
+		return nil // Keep the attribute unset if it was not set in the first place
+	}
+
+	m.Entries, diags = basetypes.NewSetValue(types.ObjectType{}.WithAttributeTypes(wifiMacFilterEntryModel{}.AttrTypes()), elements)
+	diagnostics.Append(diags...)
+
+	return diagnostics
+}
+
+func (v *wifiMacFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi_mac_filter"
+}
+
+func (v *wifiMacFilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the MAC filtering of the Wi-Fi network of the Freebox: the filtering mode and the whitelist and blacklist entries. This is a singleton resource which is authoritative over the entries: entries that are not declared are removed. Destroying this resource removes the entries and disables the filtering.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier for the singleton MAC filter resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Filtering mode: `disabled`, `whitelist` to only accept the whitelisted devices, or `blacklist` to reject the blacklisted devices",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(wifiMacFilterDisabled, wifiMacFilterWhitelist, wifiMacFilterBlacklist),
+				},
+			},
+			"entries": schema.SetNestedAttribute{
+				MarkdownDescription: "Entries of the whitelist and the blacklist",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"mac": schema.StringAttribute{
+							MarkdownDescription: "MAC address of the device",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`),
+									"Must be a valid MAC address",
+								),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "List the entry belongs to (`whitelist` or `blacklist`)",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(wifiMacFilterWhitelist, wifiMacFilterBlacklist),
+							},
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Comment of the entry",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (v *wifiMacFilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	v.client = c
+}
+
+func (v *wifiMacFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model wifiMacFilterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiMacFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model wifiMacFilterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.read(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiMacFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model wifiMacFilterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (v *wifiMacFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model wifiMacFilterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Mode = basetypes.NewStringValue(wifiMacFilterDisabled)
+	model.Entries = basetypes.NewSetNull(types.ObjectType{}.WithAttributeTypes(wifiMacFilterEntryModel{}.AttrTypes()))
+
+	resp.Diagnostics.Append(v.apply(ctx, &model)...)
+}
+
+func (v *wifiMacFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "mac_filter")...)
+}
+
+// read sets the model from the filtering mode and the filters of the Freebox.
+func (v *wifiMacFilterResource) read(ctx context.Context, model *wifiMacFilterModel) (diagnostics diag.Diagnostics) {
+	config, err := v.client.GetWifiConfig(ctx)
+	if err != nil {
+		diagnostics.AddError("Failed to read Wi-Fi configuration", err.Error())
+		return
+	}
+
+	filters, err := v.client.ListWifiMacFilters(ctx)
+	if err != nil {
+		diagnostics.AddError("Failed to list Wi-Fi MAC filters", err.Error())
+		return
+	}
+
+	return model.fromClientType(ctx, config, filters)
+}
+
+// apply reconciles the filters of the Freebox with the entries of the model, then sets the filtering mode.
+// The entries are reconciled before the mode is changed so that enabling a whitelist never locks out the declared devices.
+func (v *wifiMacFilterResource) apply(ctx context.Context, model *wifiMacFilterModel) (diagnostics diag.Diagnostics) {
+	entries, diags := model.entries(ctx)
+	if diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	filters, err := v.client.ListWifiMacFilters(ctx)
+	if err != nil {
+		diagnostics.AddError("Failed to list Wi-Fi MAC filters", err.Error())
+		return
+	}
+
+	existing := make([]bool, len(entries))
+	for _, filter := range filters {
+		index := -1
+		for i, entry := range entries {
+			if entry.matches(filter) {
+				index = i
+				break
+			}
+		}
+
+		if index < 0 {
+			if err := v.client.DeleteWifiMacFilter(ctx, filter.ID); err != nil {
+				diagnostics.AddError("Failed to delete Wi-Fi MAC filter", fmt.Sprintf("Filter: %s, Error: %s", filter.ID, err))
+				return
+			}
+			continue
+		}
+
+		existing[index] = true
+
+		if filter.Comment != entries[index].Comment.ValueString() {
+			if _, err := v.client.UpdateWifiMacFilter(ctx, filter.ID, entries[index].toPayload()); err != nil {
+				diagnostics.AddError("Failed to update Wi-Fi MAC filter", fmt.Sprintf("Filter: %s, Error: %s", filter.ID, err))
+				return
+			}
+		}
+	}
+
+	for i, entry := range entries {
+		if existing[i] {
+			continue
+		}
+		if _, err := v.client.CreateWifiMacFilter(ctx, entry.toPayload()); err != nil {
+			diagnostics.AddError("Failed to create Wi-Fi MAC filter", fmt.Sprintf("MAC: %s, Error: %s", entry.Mac.ValueString(), err))
+			return
+		}
+	}
+
+	config, err := v.client.GetWifiConfig(ctx)
+	if err != nil {
+		diagnostics.AddError("Failed to read Wi-Fi configuration", err.Error())
+		return
+	}
+
+	config.MacFilterState = model.Mode.ValueString()
+
+	if _, err := v.client.UpdateWifiConfig(ctx, config); err != nil {
+		diagnostics.AddError("Failed to update Wi-Fi MAC filtering mode", err.Error())
+		return
+	}
+
+	return v.read(ctx, model)
+}
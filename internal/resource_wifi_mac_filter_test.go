@@ -0,0 +1,187 @@
+package internal_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	freeboxTypes "github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`resource "freebox_wifi_mac_filter" { ... }`, func() {
+	var (
+		resName         string
+		config          string
+		mac             string
+		originalConfig  freeboxTypes.WifiConfig
+		originalFilters []freeboxTypes.WifiMacFilter
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		splitName := strings.Split(("test-" + uuid.New().String())[:30], "-")
+		resName = strings.Join(splitName[:len(splitName)-1], "-")
+
+		mac = fmt.Sprintf("02:00:00:00:%02x:%02x", randGenerator.Intn(256), randGenerator.Intn(256))
+
+		var err error
+		originalConfig, err = freeboxClient.GetWifiConfig(ctx)
+		Expect(err).To(BeNil())
+		originalFilters, err = freeboxClient.ListWifiMacFilters(ctx)
+		Expect(err).To(BeNil())
+
+		DeferCleanup(func(ctx SpecContext) {
+			for _, filter := range originalFilters {
+				_, err := freeboxClient.CreateWifiMacFilter(ctx, freeboxTypes.WifiMacFilterPayload{
+					Mac:     filter.Mac,
+					Type:    filter.Type,
+					Comment: filter.Comment,
+				})
+				Expect(err).To(BeNil(), "failed to restore original Wi-Fi MAC filter")
+			}
+			_, err := freeboxClient.UpdateWifiConfig(ctx, originalConfig)
+			Expect(err).To(BeNil(), "failed to restore original Wi-Fi config")
+		})
+	})
+
+	JustBeforeEach(func() {
+		// A blacklist of a fake locally administered address is used to avoid locking out any device while testing
+		config = providerBlock + `
+			resource "freebox_wifi_mac_filter" "` + resName + `" {
+				mode    = "blacklist"
+				entries = [
+					{
+						mac     = "` + mac + `"
+						type    = "blacklist"
+						comment = "` + resName + `"
+					},
+				]
+			}
+		`
+	})
+
+	It("should manage, import and remove the MAC filtering", func(ctx SpecContext) {
+		resource.UnitTest(GinkgoT(), resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("freebox_wifi_mac_filter."+resName, "id", "mac_filter"),
+						resource.TestCheckResourceAttr("freebox_wifi_mac_filter."+resName, "mode", "blacklist"),
+						resource.TestCheckResourceAttr("freebox_wifi_mac_filter."+resName, "entries.#", "1"),
+						resource.TestCheckTypeSetElemNestedAttrs("freebox_wifi_mac_filter."+resName, "entries.*", map[string]string{
+							"mac":     mac,
+							"type":    "blacklist",
+							"comment": resName,
+						}),
+						func(s *terraform.State) error {
+							config, err := freeboxClient.GetWifiConfig(ctx)
+							Expect(err).To(BeNil())
+							Expect(config.MacFilterState).To(Equal("blacklist"))
+
+							filters, err := freeboxClient.ListWifiMacFilters(ctx)
+							Expect(err).To(BeNil())
+							Expect(filters).To(HaveLen(1))
+							Expect(strings.ToLower(filters[0].Mac)).To(Equal(mac))
+							return nil
+						},
+					),
+				},
+				{
+					Config:            config,
+					ResourceName:      "freebox_wifi_mac_filter." + resName,
+					ImportState:       true,
+					ImportStateId:     "mac_filter",
+					ImportStateVerify: true,
+					ImportStateVerifyIgnore: []string{
+						"entries", // The case of the MAC addresses is the one of the Freebox when importing
+					},
+				},
+			},
+			CheckDestroy: func(s *terraform.State) error {
+				config, err := freeboxClient.GetWifiConfig(ctx)
+				Expect(err).To(BeNil())
+				Expect(config.MacFilterState).To(Equal("disabled"))
+
+				filters, err := freeboxClient.ListWifiMacFilters(ctx)
+				Expect(err).To(BeNil())
+				Expect(filters).To(BeEmpty())
+				return nil
+			},
+		})
+	})
+
+	Context("with entries built from the LAN hosts", func() {
+		var macs []string
+
+		BeforeEach(func(ctx SpecContext) {
+			hosts, err := freeboxClient.GetLanInterface(ctx, "pub")
+			Expect(err).To(BeNil())
+
+			macs = []string{}
+			for _, host := range hosts {
+				if host.L2Ident.Type == "mac_address" {
+					macs = append(macs, strings.ToLower(host.L2Ident.ID))
+				}
+			}
+			Expect(macs).ToNot(BeEmpty())
+		})
+
+		JustBeforeEach(func() {
+			// The filtering is disabled to avoid locking out any device while testing
+			config = providerBlock + `
+				data "freebox_lan_interface_hosts" "` + resName + `" {
+					interface = "pub"
+				}
+				resource "freebox_wifi_mac_filter" "` + resName + `" {
+					mode    = "disabled"
+					entries = [
+						for host in data.freebox_lan_interface_hosts.` + resName + `.hosts : {
+							mac     = host.l2ident.id
+							type    = "whitelist"
+							comment = "` + resName + `"
+						} if host.l2ident.type == "mac_address"
+					]
+				}
+			`
+		})
+
+		It("should whitelist all the hosts of the interface", func(ctx SpecContext) {
+			resource.UnitTest(GinkgoT(), resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config,
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("freebox_wifi_mac_filter."+resName, "mode", "disabled"),
+							resource.TestCheckResourceAttr("freebox_wifi_mac_filter."+resName, "entries.#", fmt.Sprintf("%d", len(macs))),
+							func(s *terraform.State) error {
+								filters, err := freeboxClient.ListWifiMacFilters(ctx)
+								Expect(err).To(BeNil())
+
+								filtered := make([]string, 0, len(filters))
+								for _, filter := range filters {
+									Expect(filter.Type).To(Equal("whitelist"))
+									Expect(filter.Comment).To(Equal(resName))
+									filtered = append(filtered, strings.ToLower(filter.Mac))
+								}
+								Expect(filtered).To(ConsistOf(macs))
+								return nil
+							},
+						),
+					},
+				},
+				CheckDestroy: func(s *terraform.State) error {
+					filters, err := freeboxClient.ListWifiMacFilters(ctx)
+					Expect(err).To(BeNil())
+					Expect(filters).To(BeEmpty())
+					return nil
+				},
+			})
+		})
+	})
+})
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	go_path "path"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -165,6 +167,68 @@ func deleteFilesIfExist(ctx context.Context, state providerdata.Setter, c client
 	return
 }
 
+// hashFile computes the checksum of a file on the Freebox with a hash task. The task is tracked in the
+// private state while it runs, and is stopped and deleted when hashing fails so that it does not leak.
+func hashFile(ctx context.Context, state providerdata.Setter, c client.Client, path string, hashType string, polling models.Polling) (hash string, diagnostics diag.Diagnostics) {
+	if hashType == "" {
+		hashType = string(freeboxTypes.HashTypeSHA256)
+	}
+
+	task, err := c.AddHashFileTask(ctx, freeboxTypes.HashPayload{
+		HashType: freeboxTypes.HashType(hashType),
+		Path:     freeboxTypes.Base64Path(path),
+	})
+	if err != nil {
+		diagnostics.AddError("Failed to request file hash", fmt.Sprintf("Path: %s, Hash type: %s, Error: %s", path, hashType, err.Error()))
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "task.id", task.ID)
+
+	tflog.Debug(ctx, "Hashing the file...")
+
+	if diags := providerdata.SetCurrentTask(ctx, state, models.TaskTypeFileSystem, task.ID); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	defer func() {
+		if !diagnostics.HasError() {
+			return
+		}
+		if err := stopAndDeleteFileSystemTask(ctx, c, task.ID); err != nil {
+			diagnostics.AddWarning("Failed to stop and delete file system task", fmt.Sprintf("Task %d, Error: %s", task.ID, err.Error()))
+			return
+		}
+		diagnostics.Append(providerdata.UnsetCurrentTask(ctx, state)...)
+	}()
+
+	if diags := waitForFileSystemTask(ctx, c, task.ID, polling); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting the hash result...")
+
+	hash, err = c.GetHashResult(ctx, task.ID)
+	if err != nil {
+		diagnostics.AddError("Failed to get hash result", fmt.Sprintf("Task %d, Path: %s, Hash type: %s, Error: %s", task.ID, path, hashType, err.Error()))
+		return
+	}
+
+	if err := stopAndDeleteFileSystemTask(ctx, c, task.ID); err != nil {
+		diagnostics.AddError("Failed to stop and delete file system task", fmt.Sprintf("Task %d, Error: %s", task.ID, err.Error()))
+		return
+	}
+
+	if diags := providerdata.UnsetCurrentTask(ctx, state); diags.HasError() {
+		diagnostics.Append(diags...)
+		return
+	}
+
+	return hash, nil
+}
+
 // waitForFileSystemTask waits for the system task to complete.
 func waitForFileSystemTask(ctx context.Context, client client.Client, taskID int64, polling models.Polling) (diagnostics diag.Diagnostics) {
 	ctx = tflog.SetField(ctx, "task.id", taskID)
@@ -511,3 +575,72 @@ func WaitForTask(ctx context.Context, c client.Client, taskType models.TaskType,
 
 	return
 }
+
+// createDirectories creates the directory and its missing parents, existing directories are left untouched.
+func createDirectories(ctx context.Context, c client.Client, directory string) (diagnostics diag.Diagnostics) {
+	var parent string = "/"
+	for _, path := range strings.Split(strings.TrimPrefix(directory, "/"), "/") {
+		newParent, err := c.CreateDirectory(ctx, parent, path)
+		if err != nil {
+			if errors.Is(err, client.ErrDestinationConflict) {
+				if fileInfo, err := c.GetFileInfo(ctx, go_path.Join(parent, path)); err != nil {
+					diagnostics.AddWarning("Failed to inspect file", fmt.Sprintf("Path: %s, Error: %s", go_path.Join(parent, path), err.Error()))
+					return
+				} else if fileInfo.Type == freeboxTypes.FileTypeDirectory {
+					parent = go_path.Join(parent, path)
+					continue
+				}
+
+				diagnostics.AddError("Failed to create parent directories", fmt.Sprintf("Path: %s, Error: %s", go_path.Join(parent, path), err.Error()))
+				return
+			}
+
+			diagnostics.AddError("Failed to create directory", fmt.Sprintf("Path: %s, Error: %s", go_path.Join(parent, path), err.Error()))
+			return
+		}
+
+		parent = newParent
+	}
+	return
+}
+
+// listFiles lists the directory and, down to maxDepth (unlimited when zero), its sub-directories.
+func listFiles(ctx context.Context, c client.Client, directory string, depth int64, maxDepth int64) ([]freeboxTypes.FileInfo, error) {
+	entries, err := c.ListFiles(ctx, directory)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]freeboxTypes.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Name == "." || entry.Name == ".." {
+			continue
+		}
+
+		files = append(files, entry)
+
+		if entry.Type != freeboxTypes.FileTypeDirectory || (maxDepth > 0 && depth >= maxDepth) {
+			continue
+		}
+
+		children, err := listFiles(ctx, c, string(entry.Path), depth+1, maxDepth)
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %w", string(entry.Path), err)
+		}
+
+		files = append(files, children...)
+	}
+
+	return files, nil
+}
+
+// updateSingletonConfig applies the model on top of the current configuration of a singleton service and
+// pushes it, so that the settings the resource does not manage and the secrets never returned are kept.
+func updateSingletonConfig[T any](ctx context.Context, get func(context.Context) (T, error), update func(context.Context, T) (T, error), apply func(current T) T) (T, error) {
+	current, err := get(ctx)
+	if err != nil {
+		return current, fmt.Errorf("read the current configuration: %w", err)
+	}
+
+	return update(ctx, apply(current))
+}